@@ -9,6 +9,8 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,455 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ResultSink int32
+
+const (
+	ResultSink_RESULT_SINK_NONE ResultSink = 0
+	// down-pvscope.io/last-resize annotation on the resized pvc
+	ResultSink_RESULT_SINK_ANNOTATION ResultSink = 1
+	// a ConfigMap per resize labeled with the pvc it resized
+	ResultSink_RESULT_SINK_CONFIGMAP ResultSink = 2
+)
+
+// Enum value maps for ResultSink.
+var (
+	ResultSink_name = map[int32]string{
+		0: "RESULT_SINK_NONE",
+		1: "RESULT_SINK_ANNOTATION",
+		2: "RESULT_SINK_CONFIGMAP",
+	}
+	ResultSink_value = map[string]int32{
+		"RESULT_SINK_NONE":       0,
+		"RESULT_SINK_ANNOTATION": 1,
+		"RESULT_SINK_CONFIGMAP":  2,
+	}
+)
+
+func (x ResultSink) Enum() *ResultSink {
+	p := new(ResultSink)
+	*p = x
+	return p
+}
+
+func (x ResultSink) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResultSink) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[0].Descriptor()
+}
+
+func (ResultSink) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[0]
+}
+
+func (x ResultSink) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResultSink.Descriptor instead.
+func (ResultSink) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{0}
+}
+
+type CopyMode int32
+
+const (
+	// copy everything while the sts is at 0
+	CopyMode_COPY_MODE_OFFLINE CopyMode = 0
+	// bulk copy from a snapshot while the sts is still running, only the final delta is copied at 0
+	CopyMode_COPY_MODE_SNAPSHOT_PRECOPY CopyMode = 1
+)
+
+// Enum value maps for CopyMode.
+var (
+	CopyMode_name = map[int32]string{
+		0: "COPY_MODE_OFFLINE",
+		1: "COPY_MODE_SNAPSHOT_PRECOPY",
+	}
+	CopyMode_value = map[string]int32{
+		"COPY_MODE_OFFLINE":          0,
+		"COPY_MODE_SNAPSHOT_PRECOPY": 1,
+	}
+)
+
+func (x CopyMode) Enum() *CopyMode {
+	p := new(CopyMode)
+	*p = x
+	return p
+}
+
+func (x CopyMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CopyMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[1].Descriptor()
+}
+
+func (CopyMode) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[1]
+}
+
+func (x CopyMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CopyMode.Descriptor instead.
+func (CopyMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{1}
+}
+
+type SnapshotRetention int32
+
+const (
+	// delete the snapshot once the resize is verified
+	SnapshotRetention_SNAPSHOT_RETENTION_DELETE_ON_SUCCESS SnapshotRetention = 0
+	// delete the snapshot together with the original pv once retain_original_for expires
+	SnapshotRetention_SNAPSHOT_RETENTION_WITH_ORIGINAL SnapshotRetention = 1
+	// never delete the snapshot
+	SnapshotRetention_SNAPSHOT_RETENTION_RETAIN SnapshotRetention = 2
+)
+
+// Enum value maps for SnapshotRetention.
+var (
+	SnapshotRetention_name = map[int32]string{
+		0: "SNAPSHOT_RETENTION_DELETE_ON_SUCCESS",
+		1: "SNAPSHOT_RETENTION_WITH_ORIGINAL",
+		2: "SNAPSHOT_RETENTION_RETAIN",
+	}
+	SnapshotRetention_value = map[string]int32{
+		"SNAPSHOT_RETENTION_DELETE_ON_SUCCESS": 0,
+		"SNAPSHOT_RETENTION_WITH_ORIGINAL":     1,
+		"SNAPSHOT_RETENTION_RETAIN":            2,
+	}
+)
+
+func (x SnapshotRetention) Enum() *SnapshotRetention {
+	p := new(SnapshotRetention)
+	*p = x
+	return p
+}
+
+func (x SnapshotRetention) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotRetention) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[2].Descriptor()
+}
+
+func (SnapshotRetention) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[2]
+}
+
+func (x SnapshotRetention) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotRetention.Descriptor instead.
+func (SnapshotRetention) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{2}
+}
+
+type RollbackSource int32
+
+const (
+	// rebind the retained original pv
+	RollbackSource_ROLLBACK_SOURCE_ORIGINAL_PV RollbackSource = 0
+	// restore a new volume from the snapshot taken during the resize
+	RollbackSource_ROLLBACK_SOURCE_SNAPSHOT RollbackSource = 1
+	// restore a new volume from the object storage backup taken during the resize
+	RollbackSource_ROLLBACK_SOURCE_BACKUP RollbackSource = 2
+)
+
+// Enum value maps for RollbackSource.
+var (
+	RollbackSource_name = map[int32]string{
+		0: "ROLLBACK_SOURCE_ORIGINAL_PV",
+		1: "ROLLBACK_SOURCE_SNAPSHOT",
+		2: "ROLLBACK_SOURCE_BACKUP",
+	}
+	RollbackSource_value = map[string]int32{
+		"ROLLBACK_SOURCE_ORIGINAL_PV": 0,
+		"ROLLBACK_SOURCE_SNAPSHOT":    1,
+		"ROLLBACK_SOURCE_BACKUP":      2,
+	}
+)
+
+func (x RollbackSource) Enum() *RollbackSource {
+	p := new(RollbackSource)
+	*p = x
+	return p
+}
+
+func (x RollbackSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RollbackSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[3].Descriptor()
+}
+
+func (RollbackSource) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[3]
+}
+
+func (x RollbackSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RollbackSource.Descriptor instead.
+func (RollbackSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{3}
+}
+
+type HookFailurePolicy int32
+
+const (
+	// fail the workflow if the hook fails in any pod - after a resize's cutover that rolls it back
+	HookFailurePolicy_HOOK_FAILURE_POLICY_ABORT HookFailurePolicy = 0
+	// record the failure and carry on
+	HookFailurePolicy_HOOK_FAILURE_POLICY_CONTINUE HookFailurePolicy = 1
+)
+
+// Enum value maps for HookFailurePolicy.
+var (
+	HookFailurePolicy_name = map[int32]string{
+		0: "HOOK_FAILURE_POLICY_ABORT",
+		1: "HOOK_FAILURE_POLICY_CONTINUE",
+	}
+	HookFailurePolicy_value = map[string]int32{
+		"HOOK_FAILURE_POLICY_ABORT":    0,
+		"HOOK_FAILURE_POLICY_CONTINUE": 1,
+	}
+)
+
+func (x HookFailurePolicy) Enum() *HookFailurePolicy {
+	p := new(HookFailurePolicy)
+	*p = x
+	return p
+}
+
+func (x HookFailurePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HookFailurePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[4].Descriptor()
+}
+
+func (HookFailurePolicy) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[4]
+}
+
+func (x HookFailurePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HookFailurePolicy.Descriptor instead.
+func (HookFailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{4}
+}
+
+type CloneSource int32
+
+const (
+	// copy from the live volume - only consistent if nothing is writing to it
+	CloneSource_CLONE_SOURCE_LIVE CloneSource = 0
+	// scale the sts to 0 while copying
+	CloneSource_CLONE_SOURCE_QUIESCE CloneSource = 1
+	// copy from a VolumeSnapshot of the pvc - the snapshot is deleted afterwards
+	CloneSource_CLONE_SOURCE_SNAPSHOT CloneSource = 2
+)
+
+// Enum value maps for CloneSource.
+var (
+	CloneSource_name = map[int32]string{
+		0: "CLONE_SOURCE_LIVE",
+		1: "CLONE_SOURCE_QUIESCE",
+		2: "CLONE_SOURCE_SNAPSHOT",
+	}
+	CloneSource_value = map[string]int32{
+		"CLONE_SOURCE_LIVE":     0,
+		"CLONE_SOURCE_QUIESCE":  1,
+		"CLONE_SOURCE_SNAPSHOT": 2,
+	}
+)
+
+func (x CloneSource) Enum() *CloneSource {
+	p := new(CloneSource)
+	*p = x
+	return p
+}
+
+func (x CloneSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CloneSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[5].Descriptor()
+}
+
+func (CloneSource) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[5]
+}
+
+func (x CloneSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CloneSource.Descriptor instead.
+func (CloneSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{5}
+}
+
+type SeedSource int32
+
+const (
+	// scale the sts to 0 while copying the source replica's volumes
+	SeedSource_SEED_SOURCE_QUIESCE SeedSource = 0
+	// restore the new volumes from VolumeSnapshots of the source replica's - the sts keeps running
+	SeedSource_SEED_SOURCE_SNAPSHOT SeedSource = 1
+)
+
+// Enum value maps for SeedSource.
+var (
+	SeedSource_name = map[int32]string{
+		0: "SEED_SOURCE_QUIESCE",
+		1: "SEED_SOURCE_SNAPSHOT",
+	}
+	SeedSource_value = map[string]int32{
+		"SEED_SOURCE_QUIESCE":  0,
+		"SEED_SOURCE_SNAPSHOT": 1,
+	}
+)
+
+func (x SeedSource) Enum() *SeedSource {
+	p := new(SeedSource)
+	*p = x
+	return p
+}
+
+func (x SeedSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeedSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[6].Descriptor()
+}
+
+func (SeedSource) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[6]
+}
+
+func (x SeedSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeedSource.Descriptor instead.
+func (SeedSource) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{6}
+}
+
+type FleetTargetStatus int32
+
+const (
+	// never started because the failure budget ran out or the fleet was cancelled
+	FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED   FleetTargetStatus = 0
+	FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED FleetTargetStatus = 1
+	FleetTargetStatus_FLEET_TARGET_STATUS_FAILED    FleetTargetStatus = 2
+)
+
+// Enum value maps for FleetTargetStatus.
+var (
+	FleetTargetStatus_name = map[int32]string{
+		0: "FLEET_TARGET_STATUS_SKIPPED",
+		1: "FLEET_TARGET_STATUS_SUCCEEDED",
+		2: "FLEET_TARGET_STATUS_FAILED",
+	}
+	FleetTargetStatus_value = map[string]int32{
+		"FLEET_TARGET_STATUS_SKIPPED":   0,
+		"FLEET_TARGET_STATUS_SUCCEEDED": 1,
+		"FLEET_TARGET_STATUS_FAILED":    2,
+	}
+)
+
+func (x FleetTargetStatus) Enum() *FleetTargetStatus {
+	p := new(FleetTargetStatus)
+	*p = x
+	return p
+}
+
+func (x FleetTargetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FleetTargetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[7].Descriptor()
+}
+
+func (FleetTargetStatus) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[7]
+}
+
+func (x FleetTargetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FleetTargetStatus.Descriptor instead.
+func (FleetTargetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{7}
+}
+
+type CampaignAction int32
+
+const (
+	// only publish the report
+	CampaignAction_CAMPAIGN_ACTION_REPORT CampaignAction = 0
+	// start an approval-gated ScaleDownWorkflow for every selected pvc
+	CampaignAction_CAMPAIGN_ACTION_RESIZE CampaignAction = 1
+)
+
+// Enum value maps for CampaignAction.
+var (
+	CampaignAction_name = map[int32]string{
+		0: "CAMPAIGN_ACTION_REPORT",
+		1: "CAMPAIGN_ACTION_RESIZE",
+	}
+	CampaignAction_value = map[string]int32{
+		"CAMPAIGN_ACTION_REPORT": 0,
+		"CAMPAIGN_ACTION_RESIZE": 1,
+	}
+)
+
+func (x CampaignAction) Enum() *CampaignAction {
+	p := new(CampaignAction)
+	*p = x
+	return p
+}
+
+func (x CampaignAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CampaignAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[8].Descriptor()
+}
+
+func (CampaignAction) Type() protoreflect.EnumType {
+	return &file_api_down_pvscope_v1_down_pvscope_proto_enumTypes[8]
+}
+
+func (x CampaignAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CampaignAction.Descriptor instead.
+func (CampaignAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{8}
+}
+
 type Scale struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -29,6 +480,35 @@ type Scale struct {
 	Pvc       string `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
 	Size      string `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
 	Sts       string `protobuf:"bytes,4,opt,name=sts,proto3" json:"sts,omitempty"`
+	// scale to 0 even if it violates a PodDisruptionBudget - every override is audited
+	AllowDisruption bool `protobuf:"varint,5,opt,name=allow_disruption,json=allowDisruption,proto3" json:"allow_disruption,omitempty"`
+	// run in every pod before scaling to 0 - falls back to the down-pvscope.io/pre-hook annotation
+	PreQuiesceHooks []*Hook `protobuf:"bytes,6,rep,name=pre_quiesce_hooks,json=preQuiesceHooks,proto3" json:"pre_quiesce_hooks,omitempty"`
+	// run in every pod after scaling back up - falls back to the down-pvscope.io/post-hook annotation
+	PostResumeHooks []*Hook `protobuf:"bytes,7,rep,name=post_resume_hooks,json=postResumeHooks,proto3" json:"post_resume_hooks,omitempty"`
+	// checks run after scaling back up - a failure rolls the claim back to the original pv
+	Verification *Verification `protobuf:"bytes,8,opt,name=verification,proto3" json:"verification,omitempty"`
+	// delete the original pv this long after a successful resize - unset keeps it forever
+	RetainOriginalFor *durationpb.Duration `protobuf:"bytes,9,opt,name=retain_original_for,json=retainOriginalFor,proto3" json:"retain_original_for,omitempty"`
+	// snapshot the original pvc once the sts is at 0 and before anything is copied
+	Snapshot *Snapshot `protobuf:"bytes,10,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// copy the original pvc to object storage before it is deleted
+	Backup   *Backup  `protobuf:"bytes,11,opt,name=backup,proto3" json:"backup,omitempty"`
+	CopyMode CopyMode `protobuf:"varint,12,opt,name=copy_mode,json=copyMode,proto3,enum=workflows.scaler.v1.CopyMode" json:"copy_mode,omitempty"`
+	// class of the pre-copy snapshot in COPY_MODE_SNAPSHOT_PRECOPY - the default class when empty
+	PrecopySnapshotClass string `protobuf:"bytes,13,opt,name=precopy_snapshot_class,json=precopySnapshotClass,proto3" json:"precopy_snapshot_class,omitempty"`
+	// refuse to start when the sampled copy estimate exceeds this and abort the copy if the sts
+	// has been at 0 for longer - unset means no limit
+	MaxDowntime *durationpb.Duration `protobuf:"bytes,14,opt,name=max_downtime,json=maxDowntime,proto3" json:"max_downtime,omitempty"`
+	// only scale to 0 inside this window - falls back to the down-pvscope.io/maintenance-window namespace annotation
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,15,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	// wait for a human to approve the plan before scaling to 0
+	Approval *Approval `protobuf:"bytes,16,opt,name=approval,proto3" json:"approval,omitempty"`
+	// park before these steps until a resume signal:
+	// before-scale-down, before-delete-original, before-rebind, before-scale-up
+	Breakpoints []string `protobuf:"bytes,17,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+	// also keep the ScaleResult in the namespace for later auditing
+	PersistResult ResultSink `protobuf:"varint,18,opt,name=persist_result,json=persistResult,proto3,enum=workflows.scaler.v1.ResultSink" json:"persist_result,omitempty"`
 }
 
 func (x *Scale) Reset() {
@@ -91,57 +571,4128 @@ func (x *Scale) GetSts() string {
 	return ""
 }
 
-var File_api_down_pvscope_v1_down_pvscope_proto protoreflect.FileDescriptor
+func (x *Scale) GetAllowDisruption() bool {
+	if x != nil {
+		return x.AllowDisruption
+	}
+	return false
+}
 
-var file_api_down_pvscope_v1_down_pvscope_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x5d, 0x0a,
-	0x05, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x42, 0x3a, 0x5a, 0x38,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x72, 0x6f, 0x6e,
-	0x73, 0x68, 0x69, 0x66, 0x6d, 0x61, 0x6e, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Scale) GetPreQuiesceHooks() []*Hook {
+	if x != nil {
+		return x.PreQuiesceHooks
+	}
+	return nil
 }
 
-var (
-	file_api_down_pvscope_v1_down_pvscope_proto_rawDescOnce sync.Once
-	file_api_down_pvscope_v1_down_pvscope_proto_rawDescData = file_api_down_pvscope_v1_down_pvscope_proto_rawDesc
-)
+func (x *Scale) GetPostResumeHooks() []*Hook {
+	if x != nil {
+		return x.PostResumeHooks
+	}
+	return nil
+}
 
-func file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP() []byte {
-	file_api_down_pvscope_v1_down_pvscope_proto_rawDescOnce.Do(func() {
-		file_api_down_pvscope_v1_down_pvscope_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_down_pvscope_v1_down_pvscope_proto_rawDescData)
-	})
-	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescData
+func (x *Scale) GetVerification() *Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
 }
 
-var file_api_down_pvscope_v1_down_pvscope_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_api_down_pvscope_v1_down_pvscope_proto_goTypes = []interface{}{
-	(*Scale)(nil), // 0: workflows.scaler.v1.Scale
+func (x *Scale) GetRetainOriginalFor() *durationpb.Duration {
+	if x != nil {
+		return x.RetainOriginalFor
+	}
+	return nil
 }
-var file_api_down_pvscope_v1_down_pvscope_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *Scale) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
 }
 
-func init() { file_api_down_pvscope_v1_down_pvscope_proto_init() }
-func file_api_down_pvscope_v1_down_pvscope_proto_init() {
-	if File_api_down_pvscope_v1_down_pvscope_proto != nil {
-		return
+func (x *Scale) GetBackup() *Backup {
+	if x != nil {
+		return x.Backup
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Scale); i {
+	return nil
+}
+
+func (x *Scale) GetCopyMode() CopyMode {
+	if x != nil {
+		return x.CopyMode
+	}
+	return CopyMode_COPY_MODE_OFFLINE
+}
+
+func (x *Scale) GetPrecopySnapshotClass() string {
+	if x != nil {
+		return x.PrecopySnapshotClass
+	}
+	return ""
+}
+
+func (x *Scale) GetMaxDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDowntime
+	}
+	return nil
+}
+
+func (x *Scale) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+func (x *Scale) GetApproval() *Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *Scale) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+func (x *Scale) GetPersistResult() ResultSink {
+	if x != nil {
+		return x.PersistResult
+	}
+	return ResultSink_RESULT_SINK_NONE
+}
+
+// ScaleResult is what a successful ScaleDownWorkflow returns
+type ScaleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pvc       string `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	Sts       string `protobuf:"bytes,3,opt,name=sts,proto3" json:"sts,omitempty"`
+	OldSize   string `protobuf:"bytes,4,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	NewSize   string `protobuf:"bytes,5,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+	// old_pv is retained until retain_original_for passes (and deleted when original_pv_deleted)
+	OldPv             string `protobuf:"bytes,6,opt,name=old_pv,json=oldPv,proto3" json:"old_pv,omitempty"`
+	NewPv             string `protobuf:"bytes,7,opt,name=new_pv,json=newPv,proto3" json:"new_pv,omitempty"`
+	OriginalPvDeleted bool   `protobuf:"varint,8,opt,name=original_pv_deleted,json=originalPvDeleted,proto3" json:"original_pv_deleted,omitempty"`
+	StorageClass      string `protobuf:"bytes,9,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+	// old_size - new_size
+	ReclaimedBytes int64 `protobuf:"varint,10,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3" json:"reclaimed_bytes,omitempty"`
+	// final stats of the copy made at 0 replicas
+	Mover        *CopyProgress        `protobuf:"bytes,11,opt,name=mover,proto3" json:"mover,omitempty"`
+	Verification *VerificationSummary `protobuf:"bytes,12,opt,name=verification,proto3" json:"verification,omitempty"`
+	// time the sts spent at 0 replicas
+	Downtime          *durationpb.Duration `protobuf:"bytes,13,opt,name=downtime,proto3" json:"downtime,omitempty"`
+	EstimatedDowntime *durationpb.Duration `protobuf:"bytes,14,opt,name=estimated_downtime,json=estimatedDowntime,proto3" json:"estimated_downtime,omitempty"`
+	// snapshot and backup of the original pvc (if any)
+	Snapshot       string `protobuf:"bytes,15,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	BackupManifest string `protobuf:"bytes,16,opt,name=backup_manifest,json=backupManifest,proto3" json:"backup_manifest,omitempty"`
+	Approver       string `protobuf:"bytes,17,opt,name=approver,proto3" json:"approver,omitempty"`
+	// everything that was overridden or went wrong without failing the resize
+	Warnings   []string               `protobuf:"bytes,18,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	WorkflowId string                 `protobuf:"bytes,21,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// nothing was changed - the result shows what the preflight checks found
+	DryRun bool `protobuf:"varint,22,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// what a dry run would have done
+	Plan *ScalePlan `protobuf:"bytes,23,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *ScaleResult) Reset() {
+	*x = ScaleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScaleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleResult) ProtoMessage() {}
+
+func (x *ScaleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleResult.ProtoReflect.Descriptor instead.
+func (*ScaleResult) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScaleResult) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetSts() string {
+	if x != nil {
+		return x.Sts
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetOldSize() string {
+	if x != nil {
+		return x.OldSize
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetNewSize() string {
+	if x != nil {
+		return x.NewSize
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetOldPv() string {
+	if x != nil {
+		return x.OldPv
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetNewPv() string {
+	if x != nil {
+		return x.NewPv
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetOriginalPvDeleted() bool {
+	if x != nil {
+		return x.OriginalPvDeleted
+	}
+	return false
+}
+
+func (x *ScaleResult) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetReclaimedBytes() int64 {
+	if x != nil {
+		return x.ReclaimedBytes
+	}
+	return 0
+}
+
+func (x *ScaleResult) GetMover() *CopyProgress {
+	if x != nil {
+		return x.Mover
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetVerification() *VerificationSummary {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.Downtime
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetEstimatedDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.EstimatedDowntime
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetBackupManifest() string {
+	if x != nil {
+		return x.BackupManifest
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ScaleResult) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ScaleResult) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ScaleResult) GetPlan() *ScalePlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// ScalePlan is every change a resize would make, in order
+type ScalePlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps            []*PlanStep          `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	ExpectedDowntime *durationpb.Duration `protobuf:"bytes,2,opt,name=expected_downtime,json=expectedDowntime,proto3" json:"expected_downtime,omitempty"`
+	// false when the downtime is assumed from the pvc's size rather than sampled
+	DowntimeSampled bool        `protobuf:"varint,3,opt,name=downtime_sampled,json=downtimeSampled,proto3" json:"downtime_sampled,omitempty"`
+	Manifests       []*Manifest `protobuf:"bytes,4,rep,name=manifests,proto3" json:"manifests,omitempty"`
+}
+
+func (x *ScalePlan) Reset() {
+	*x = ScalePlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalePlan) ProtoMessage() {}
+
+func (x *ScalePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalePlan.ProtoReflect.Descriptor instead.
+func (*ScalePlan) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScalePlan) GetSteps() []*PlanStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *ScalePlan) GetExpectedDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.ExpectedDowntime
+	}
+	return nil
+}
+
+func (x *ScalePlan) GetDowntimeSampled() bool {
+	if x != nil {
+		return x.DowntimeSampled
+	}
+	return false
+}
+
+func (x *ScalePlan) GetManifests() []*Manifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type PlanStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Detail string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *PlanStep) Reset() {
+	*x = PlanStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanStep) ProtoMessage() {}
+
+func (x *PlanStep) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanStep.ProtoReflect.Descriptor instead.
+func (*PlanStep) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlanStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanStep) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Yaml string `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	// accepted by a server-side dry run rather than only rendered locally
+	ServerValidated bool `protobuf:"varint,3,opt,name=server_validated,json=serverValidated,proto3" json:"server_validated,omitempty"`
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Manifest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Manifest) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+func (x *Manifest) GetServerValidated() bool {
+	if x != nil {
+		return x.ServerValidated
+	}
+	return false
+}
+
+type VerificationSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// false when no verification was requested
+	Verified  bool                 `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	StableFor *durationpb.Duration `protobuf:"bytes,2,opt,name=stable_for,json=stableFor,proto3" json:"stable_for,omitempty"`
+	// name of the probe hook (if any)
+	Probe string `protobuf:"bytes,3,opt,name=probe,proto3" json:"probe,omitempty"`
+}
+
+func (x *VerificationSummary) Reset() {
+	*x = VerificationSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerificationSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationSummary) ProtoMessage() {}
+
+func (x *VerificationSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationSummary.ProtoReflect.Descriptor instead.
+func (*VerificationSummary) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerificationSummary) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *VerificationSummary) GetStableFor() *durationpb.Duration {
+	if x != nil {
+		return x.StableFor
+	}
+	return nil
+}
+
+func (x *VerificationSummary) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+// Approval blocks the workflow after staging until an ApprovalDecision arrives
+type Approval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required bool `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// reject automatically when nobody decides in time - unset waits forever
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *Approval) Reset() {
+	*x = Approval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Approval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Approval) ProtoMessage() {}
+
+func (x *Approval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Approval.ProtoReflect.Descriptor instead.
+func (*Approval) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Approval) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Approval) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// ApprovalDecision is the payload of the approval signal and update
+type ApprovalDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approved bool   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ApprovalDecision) Reset() {
+	*x = ApprovalDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (x *ApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApprovalDecision) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApprovalDecision) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// MaintenanceWindow is a recurring local time range e.g. weeknights 01:00-04:00
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// days the window opens on ("mon" ... "sun") - every day when empty
+	Days []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// "HH:MM" the window opens and closes - an end before the start closes on the next day
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// IANA zone e.g. "America/Toronto" - falls back to the down-pvscope.io/timezone namespace annotation, then UTC
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MaintenanceWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// ScaleStatus is what the status query of a ScaleDownWorkflow returns
+type ScaleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// step the workflow is in right now - "done" once it has returned
+	Step            string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	StepStartedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=step_started_at,json=stepStartedAt,proto3" json:"step_started_at,omitempty"`
+	CompletedSteps  []*StepTiming          `protobuf:"bytes,3,rep,name=completed_steps,json=completedSteps,proto3" json:"completed_steps,omitempty"`
+	OriginalPvc     string                 `protobuf:"bytes,4,opt,name=original_pvc,json=originalPvc,proto3" json:"original_pvc,omitempty"`
+	OriginalPv      string                 `protobuf:"bytes,5,opt,name=original_pv,json=originalPv,proto3" json:"original_pv,omitempty"`
+	StagingPvc      string                 `protobuf:"bytes,6,opt,name=staging_pvc,json=stagingPvc,proto3" json:"staging_pvc,omitempty"`
+	StagingPv       string                 `protobuf:"bytes,7,opt,name=staging_pv,json=stagingPv,proto3" json:"staging_pv,omitempty"`
+	InitialReplicas int32                  `protobuf:"varint,8,opt,name=initial_replicas,json=initialReplicas,proto3" json:"initial_replicas,omitempty"`
+	// latest progress heartbeated by a running copy job - filled in by the cli from the pending activity,
+	// the status query leaves it empty
+	CopyProgress *CopyProgress `protobuf:"bytes,9,opt,name=copy_progress,json=copyProgress,proto3" json:"copy_progress,omitempty"`
+	// set while the plan waits for an approval decision
+	PendingApproval *PendingApproval `protobuf:"bytes,10,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	// breakpoint the workflow is parked at (if any)
+	ParkedAt string `protobuf:"bytes,11,opt,name=parked_at,json=parkedAt,proto3" json:"parked_at,omitempty"`
+}
+
+func (x *ScaleStatus) Reset() {
+	*x = ScaleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScaleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleStatus) ProtoMessage() {}
+
+func (x *ScaleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleStatus.ProtoReflect.Descriptor instead.
+func (*ScaleStatus) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScaleStatus) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *ScaleStatus) GetStepStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StepStartedAt
+	}
+	return nil
+}
+
+func (x *ScaleStatus) GetCompletedSteps() []*StepTiming {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
+func (x *ScaleStatus) GetOriginalPvc() string {
+	if x != nil {
+		return x.OriginalPvc
+	}
+	return ""
+}
+
+func (x *ScaleStatus) GetOriginalPv() string {
+	if x != nil {
+		return x.OriginalPv
+	}
+	return ""
+}
+
+func (x *ScaleStatus) GetStagingPvc() string {
+	if x != nil {
+		return x.StagingPvc
+	}
+	return ""
+}
+
+func (x *ScaleStatus) GetStagingPv() string {
+	if x != nil {
+		return x.StagingPv
+	}
+	return ""
+}
+
+func (x *ScaleStatus) GetInitialReplicas() int32 {
+	if x != nil {
+		return x.InitialReplicas
+	}
+	return 0
+}
+
+func (x *ScaleStatus) GetCopyProgress() *CopyProgress {
+	if x != nil {
+		return x.CopyProgress
+	}
+	return nil
+}
+
+func (x *ScaleStatus) GetPendingApproval() *PendingApproval {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return nil
+}
+
+func (x *ScaleStatus) GetParkedAt() string {
+	if x != nil {
+		return x.ParkedAt
+	}
+	return ""
+}
+
+type StepTiming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *StepTiming) Reset() {
+	*x = StepTiming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepTiming) ProtoMessage() {}
+
+func (x *StepTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepTiming.ProtoReflect.Descriptor instead.
+func (*StepTiming) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StepTiming) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepTiming) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *StepTiming) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// CopyProgress is rclone's transfer stats for a copy job
+type CopyProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job            string                 `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Bytes          int64                  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	TotalBytes     int64                  `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	Transfers      int64                  `protobuf:"varint,4,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	TotalTransfers int64                  `protobuf:"varint,5,opt,name=total_transfers,json=totalTransfers,proto3" json:"total_transfers,omitempty"`
+	Eta            *durationpb.Duration   `protobuf:"bytes,6,opt,name=eta,proto3" json:"eta,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CopyProgress) Reset() {
+	*x = CopyProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyProgress) ProtoMessage() {}
+
+func (x *CopyProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyProgress.ProtoReflect.Descriptor instead.
+func (*CopyProgress) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CopyProgress) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *CopyProgress) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *CopyProgress) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *CopyProgress) GetTransfers() int64 {
+	if x != nil {
+		return x.Transfers
+	}
+	return 0
+}
+
+func (x *CopyProgress) GetTotalTransfers() int64 {
+	if x != nil {
+		return x.TotalTransfers
+	}
+	return 0
+}
+
+func (x *CopyProgress) GetEta() *durationpb.Duration {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *CopyProgress) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PendingApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// unset when the approval never times out
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *PendingApproval) Reset() {
+	*x = PendingApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingApproval) ProtoMessage() {}
+
+func (x *PendingApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingApproval.ProtoReflect.Descriptor instead.
+func (*PendingApproval) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PendingApproval) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Backup copies a pvc to an S3-compatible remote with rclone
+type Backup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// secret in the pvc's namespace holding access_key_id and secret_access_key
+	// and optionally endpoint, region and provider
+	SecretName string `protobuf:"bytes,2,opt,name=secret_name,json=secretName,proto3" json:"secret_name,omitempty"`
+	Bucket     string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// defaults to <namespace>/<pvc>/<workflow run id>
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *Backup) Reset() {
+	*x = Backup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Backup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup) ProtoMessage() {}
+
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Backup) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Backup) GetSecretName() string {
+	if x != nil {
+		return x.SecretName
+	}
+	return ""
+}
+
+func (x *Backup) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Backup) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// Snapshot takes a CSI VolumeSnapshot of the source pvc as a safety net
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// uses the default VolumeSnapshotClass when empty
+	VolumeSnapshotClass string            `protobuf:"bytes,2,opt,name=volume_snapshot_class,json=volumeSnapshotClass,proto3" json:"volume_snapshot_class,omitempty"`
+	Retention           SnapshotRetention `protobuf:"varint,3,opt,name=retention,proto3,enum=workflows.scaler.v1.SnapshotRetention" json:"retention,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Snapshot) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Snapshot) GetVolumeSnapshotClass() string {
+	if x != nil {
+		return x.VolumeSnapshotClass
+	}
+	return ""
+}
+
+func (x *Snapshot) GetRetention() SnapshotRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return SnapshotRetention_SNAPSHOT_RETENTION_DELETE_ON_SUCCESS
+}
+
+// Rollback puts the original volume of a completed resize back under its claim
+type Rollback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the ScaleDownWorkflow being undone
+	WorkflowId string `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// copy everything written since the resize back onto the original volume first
+	Sync            bool           `protobuf:"varint,2,opt,name=sync,proto3" json:"sync,omitempty"`
+	PreQuiesceHooks []*Hook        `protobuf:"bytes,3,rep,name=pre_quiesce_hooks,json=preQuiesceHooks,proto3" json:"pre_quiesce_hooks,omitempty"`
+	PostResumeHooks []*Hook        `protobuf:"bytes,4,rep,name=post_resume_hooks,json=postResumeHooks,proto3" json:"post_resume_hooks,omitempty"`
+	Source          RollbackSource `protobuf:"varint,5,opt,name=source,proto3,enum=workflows.scaler.v1.RollbackSource" json:"source,omitempty"`
+}
+
+func (x *Rollback) Reset() {
+	*x = Rollback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollback) ProtoMessage() {}
+
+func (x *Rollback) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollback.ProtoReflect.Descriptor instead.
+func (*Rollback) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Rollback) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Rollback) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *Rollback) GetPreQuiesceHooks() []*Hook {
+	if x != nil {
+		return x.PreQuiesceHooks
+	}
+	return nil
+}
+
+func (x *Rollback) GetPostResumeHooks() []*Hook {
+	if x != nil {
+		return x.PostResumeHooks
+	}
+	return nil
+}
+
+func (x *Rollback) GetSource() RollbackSource {
+	if x != nil {
+		return x.Source
+	}
+	return RollbackSource_ROLLBACK_SOURCE_ORIGINAL_PV
+}
+
+// Hook runs either a command (through pod exec) or an http call against each pod of the workload
+type Hook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Command []string `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	// container to exec in - defaults to the first container in the pod
+	Container string    `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Http      *HttpHook `protobuf:"bytes,4,opt,name=http,proto3" json:"http,omitempty"`
+	// defaults to 1 minute per pod
+	Timeout       *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	FailurePolicy HookFailurePolicy    `protobuf:"varint,6,opt,name=failure_policy,json=failurePolicy,proto3,enum=workflows.scaler.v1.HookFailurePolicy" json:"failure_policy,omitempty"`
+}
+
+func (x *Hook) Reset() {
+	*x = Hook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hook) ProtoMessage() {}
+
+func (x *Hook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
+func (*Hook) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Hook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hook) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Hook) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *Hook) GetHttp() *HttpHook {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Hook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Hook) GetFailurePolicy() HookFailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return HookFailurePolicy_HOOK_FAILURE_POLICY_ABORT
+}
+
+// HttpHook calls the pod ip directly
+type HttpHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port int32  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// defaults to GET
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// only http (the default) - the pod ip has no certificate an https hook could verify
+	Scheme string `protobuf:"bytes,4,opt,name=scheme,proto3" json:"scheme,omitempty"`
+}
+
+func (x *HttpHook) Reset() {
+	*x = HttpHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpHook) ProtoMessage() {}
+
+func (x *HttpHook) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpHook.ProtoReflect.Descriptor instead.
+func (*HttpHook) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HttpHook) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HttpHook) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HttpHook) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpHook) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+// Verification holds the workload to a higher bar than ReadyReplicas == replicas once it is resumed
+type Verification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// every pod must stay ready, without restarting or crashlooping, for this long
+	StableFor *durationpb.Duration `protobuf:"bytes,1,opt,name=stable_for,json=stableFor,proto3" json:"stable_for,omitempty"`
+	// optional http call or command run in each pod once the stable period has passed
+	Probe *Hook `protobuf:"bytes,2,opt,name=probe,proto3" json:"probe,omitempty"`
+}
+
+func (x *Verification) Reset() {
+	*x = Verification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Verification) ProtoMessage() {}
+
+func (x *Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
+func (*Verification) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Verification) GetStableFor() *durationpb.Duration {
+	if x != nil {
+		return x.StableFor
+	}
+	return nil
+}
+
+func (x *Verification) GetProbe() *Hook {
+	if x != nil {
+		return x.Probe
+	}
+	return nil
+}
+
+// PvcSpec is the portable part of a pvc - enough to provision an equivalent claim in another cluster
+type PvcSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace        string            `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels           map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StorageClassName string            `protobuf:"bytes,4,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	AccessModes      []string          `protobuf:"bytes,5,rep,name=access_modes,json=accessModes,proto3" json:"access_modes,omitempty"`
+	RequestedStorage string            `protobuf:"bytes,6,opt,name=requested_storage,json=requestedStorage,proto3" json:"requested_storage,omitempty"`
+}
+
+func (x *PvcSpec) Reset() {
+	*x = PvcSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PvcSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PvcSpec) ProtoMessage() {}
+
+func (x *PvcSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PvcSpec.ProtoReflect.Descriptor instead.
+func (*PvcSpec) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PvcSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PvcSpec) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PvcSpec) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PvcSpec) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PvcSpec) GetAccessModes() []string {
+	if x != nil {
+		return x.AccessModes
+	}
+	return nil
+}
+
+func (x *PvcSpec) GetRequestedStorage() string {
+	if x != nil {
+		return x.RequestedStorage
+	}
+	return ""
+}
+
+// Export streams a (quiesced) pvc to object storage
+type Export struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pvc       string `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	// scaled to 0 during the export and back up afterwards - leave empty if nothing mounts the pvc
+	Sts string `protobuf:"bytes,3,opt,name=sts,proto3" json:"sts,omitempty"`
+	// enabled is ignored, the prefix defaults to <namespace>/<pvc>/<workflow run id>
+	Destination     *Backup `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	PreQuiesceHooks []*Hook `protobuf:"bytes,5,rep,name=pre_quiesce_hooks,json=preQuiesceHooks,proto3" json:"pre_quiesce_hooks,omitempty"`
+	PostResumeHooks []*Hook `protobuf:"bytes,6,rep,name=post_resume_hooks,json=postResumeHooks,proto3" json:"post_resume_hooks,omitempty"`
+}
+
+func (x *Export) Reset() {
+	*x = Export{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Export) ProtoMessage() {}
+
+func (x *Export) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Export.ProtoReflect.Descriptor instead.
+func (*Export) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Export) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Export) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *Export) GetSts() string {
+	if x != nil {
+		return x.Sts
+	}
+	return ""
+}
+
+func (x *Export) GetDestination() *Backup {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Export) GetPreQuiesceHooks() []*Hook {
+	if x != nil {
+		return x.PreQuiesceHooks
+	}
+	return nil
+}
+
+func (x *Export) GetPostResumeHooks() []*Hook {
+	if x != nil {
+		return x.PostResumeHooks
+	}
+	return nil
+}
+
+type ExportResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pvc            *PvcSpec `protobuf:"bytes,1,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	Location       *Backup  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Manifest       string   `protobuf:"bytes,3,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	ManifestSha256 string   `protobuf:"bytes,4,opt,name=manifest_sha256,json=manifestSha256,proto3" json:"manifest_sha256,omitempty"`
+}
+
+func (x *ExportResult) Reset() {
+	*x = ExportResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResult) ProtoMessage() {}
+
+func (x *ExportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResult.ProtoReflect.Descriptor instead.
+func (*ExportResult) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportResult) GetPvc() *PvcSpec {
+	if x != nil {
+		return x.Pvc
+	}
+	return nil
+}
+
+func (x *ExportResult) GetLocation() *Backup {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ExportResult) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *ExportResult) GetManifestSha256() string {
+	if x != nil {
+		return x.ManifestSha256
+	}
+	return ""
+}
+
+// Import provisions a pvc from an exported spec and restores the exported data into it
+type Import struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// usually the location of an ExportResult with secret_name pointing at a secret in the target namespace
+	Source         *Backup  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	ManifestSha256 string   `protobuf:"bytes,2,opt,name=manifest_sha256,json=manifestSha256,proto3" json:"manifest_sha256,omitempty"`
+	Pvc            *PvcSpec `protobuf:"bytes,3,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	// optional overrides of the exported spec for the target cluster
+	Namespace        string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name             string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	StorageClassName string `protobuf:"bytes,6,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	Size             string `protobuf:"bytes,7,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *Import) Reset() {
+	*x = Import{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Import) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Import) ProtoMessage() {}
+
+func (x *Import) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Import.ProtoReflect.Descriptor instead.
+func (*Import) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Import) GetSource() *Backup {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *Import) GetManifestSha256() string {
+	if x != nil {
+		return x.ManifestSha256
+	}
+	return ""
+}
+
+func (x *Import) GetPvc() *PvcSpec {
+	if x != nil {
+		return x.Pvc
+	}
+	return nil
+}
+
+func (x *Import) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Import) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Import) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *Import) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+// Clone copies a pvc into a new claim, the original claim is never deleted or rebound
+type Clone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pvc       string `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	// defaults to the source namespace
+	TargetNamespace string `protobuf:"bytes,3,opt,name=target_namespace,json=targetNamespace,proto3" json:"target_namespace,omitempty"`
+	TargetName      string `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	// default to the source pvc's size and class
+	Size             string      `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	StorageClassName string      `protobuf:"bytes,6,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	Source           CloneSource `protobuf:"varint,7,opt,name=source,proto3,enum=workflows.scaler.v1.CloneSource" json:"source,omitempty"`
+	// quiesced in CLONE_SOURCE_QUIESCE mode
+	Sts             string  `protobuf:"bytes,8,opt,name=sts,proto3" json:"sts,omitempty"`
+	PreQuiesceHooks []*Hook `protobuf:"bytes,9,rep,name=pre_quiesce_hooks,json=preQuiesceHooks,proto3" json:"pre_quiesce_hooks,omitempty"`
+	PostResumeHooks []*Hook `protobuf:"bytes,10,rep,name=post_resume_hooks,json=postResumeHooks,proto3" json:"post_resume_hooks,omitempty"`
+	// used in CLONE_SOURCE_SNAPSHOT mode, the default class when empty
+	VolumeSnapshotClass string `protobuf:"bytes,11,opt,name=volume_snapshot_class,json=volumeSnapshotClass,proto3" json:"volume_snapshot_class,omitempty"`
+}
+
+func (x *Clone) Reset() {
+	*x = Clone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clone) ProtoMessage() {}
+
+func (x *Clone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clone.ProtoReflect.Descriptor instead.
+func (*Clone) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Clone) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Clone) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *Clone) GetTargetNamespace() string {
+	if x != nil {
+		return x.TargetNamespace
+	}
+	return ""
+}
+
+func (x *Clone) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *Clone) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Clone) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *Clone) GetSource() CloneSource {
+	if x != nil {
+		return x.Source
+	}
+	return CloneSource_CLONE_SOURCE_LIVE
+}
+
+func (x *Clone) GetSts() string {
+	if x != nil {
+		return x.Sts
+	}
+	return ""
+}
+
+func (x *Clone) GetPreQuiesceHooks() []*Hook {
+	if x != nil {
+		return x.PreQuiesceHooks
+	}
+	return nil
+}
+
+func (x *Clone) GetPostResumeHooks() []*Hook {
+	if x != nil {
+		return x.PostResumeHooks
+	}
+	return nil
+}
+
+func (x *Clone) GetVolumeSnapshotClass() string {
+	if x != nil {
+		return x.VolumeSnapshotClass
+	}
+	return ""
+}
+
+// SeedReplicas pre-populates the volumes of new sts ordinals from an existing replica before scaling up
+type SeedReplicas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Sts       string `protobuf:"bytes,2,opt,name=sts,proto3" json:"sts,omitempty"`
+	// the new replica count - must be more than the current one
+	Replicas      int32      `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	SourceOrdinal int32      `protobuf:"varint,4,opt,name=source_ordinal,json=sourceOrdinal,proto3" json:"source_ordinal,omitempty"`
+	Source        SeedSource `protobuf:"varint,5,opt,name=source,proto3,enum=workflows.scaler.v1.SeedSource" json:"source,omitempty"`
+	// used in SEED_SOURCE_SNAPSHOT mode, the default class when empty
+	VolumeSnapshotClass string  `protobuf:"bytes,6,opt,name=volume_snapshot_class,json=volumeSnapshotClass,proto3" json:"volume_snapshot_class,omitempty"`
+	PreQuiesceHooks     []*Hook `protobuf:"bytes,7,rep,name=pre_quiesce_hooks,json=preQuiesceHooks,proto3" json:"pre_quiesce_hooks,omitempty"`
+	PostResumeHooks     []*Hook `protobuf:"bytes,8,rep,name=post_resume_hooks,json=postResumeHooks,proto3" json:"post_resume_hooks,omitempty"`
+}
+
+func (x *SeedReplicas) Reset() {
+	*x = SeedReplicas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedReplicas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedReplicas) ProtoMessage() {}
+
+func (x *SeedReplicas) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedReplicas.ProtoReflect.Descriptor instead.
+func (*SeedReplicas) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SeedReplicas) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SeedReplicas) GetSts() string {
+	if x != nil {
+		return x.Sts
+	}
+	return ""
+}
+
+func (x *SeedReplicas) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *SeedReplicas) GetSourceOrdinal() int32 {
+	if x != nil {
+		return x.SourceOrdinal
+	}
+	return 0
+}
+
+func (x *SeedReplicas) GetSource() SeedSource {
+	if x != nil {
+		return x.Source
+	}
+	return SeedSource_SEED_SOURCE_QUIESCE
+}
+
+func (x *SeedReplicas) GetVolumeSnapshotClass() string {
+	if x != nil {
+		return x.VolumeSnapshotClass
+	}
+	return ""
+}
+
+func (x *SeedReplicas) GetPreQuiesceHooks() []*Hook {
+	if x != nil {
+		return x.PreQuiesceHooks
+	}
+	return nil
+}
+
+func (x *SeedReplicas) GetPostResumeHooks() []*Hook {
+	if x != nil {
+		return x.PostResumeHooks
+	}
+	return nil
+}
+
+// Fleet resizes many pvcs, each as a child ScaleDownWorkflow
+type Fleet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets []*Scale `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	// children running at once, 1 when unset
+	MaxConcurrency int32 `protobuf:"varint,2,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// children running at once in a single namespace, unlimited when unset
+	MaxPerNamespace int32 `protobuf:"varint,3,opt,name=max_per_namespace,json=maxPerNamespace,proto3" json:"max_per_namespace,omitempty"`
+	// no more children are started once this many failed, unlimited when unset
+	MaxFailures int32 `protobuf:"varint,4,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// outcomes of earlier runs carried over continue-as-new, without their results or errors - leave empty
+	Outcomes []*FleetOutcome `protobuf:"bytes,5,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+}
+
+func (x *Fleet) Reset() {
+	*x = Fleet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fleet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fleet) ProtoMessage() {}
+
+func (x *Fleet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fleet.ProtoReflect.Descriptor instead.
+func (*Fleet) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Fleet) GetTargets() []*Scale {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Fleet) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *Fleet) GetMaxPerNamespace() int32 {
+	if x != nil {
+		return x.MaxPerNamespace
+	}
+	return 0
+}
+
+func (x *Fleet) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *Fleet) GetOutcomes() []*FleetOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type FleetOutcome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace  string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pvc        string            `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	WorkflowId string            `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Status     FleetTargetStatus `protobuf:"varint,4,opt,name=status,proto3,enum=workflows.scaler.v1.FleetTargetStatus" json:"status,omitempty"`
+	// error and result are only set for children of the current run, the cli reads them back from the child
+	// (by workflow_id and run_id) for earlier ones
+	Error  string       `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Result *ScaleResult `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	RunId  string       `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *FleetOutcome) Reset() {
+	*x = FleetOutcome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetOutcome) ProtoMessage() {}
+
+func (x *FleetOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetOutcome.ProtoReflect.Descriptor instead.
+func (*FleetOutcome) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FleetOutcome) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *FleetOutcome) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *FleetOutcome) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *FleetOutcome) GetStatus() FleetTargetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED
+}
+
+func (x *FleetOutcome) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FleetOutcome) GetResult() *ScaleResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *FleetOutcome) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type FleetReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcomes  []*FleetOutcome `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"`
+	Succeeded int32           `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32           `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped   int32           `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// targets not finished yet, only set while the fleet is running
+	Remaining int32 `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *FleetReport) Reset() {
+	*x = FleetReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetReport) ProtoMessage() {}
+
+func (x *FleetReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetReport.ProtoReflect.Descriptor instead.
+func (*FleetReport) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FleetReport) GetOutcomes() []*FleetOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+func (x *FleetReport) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *FleetReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *FleetReport) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *FleetReport) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// Scan looks for pvcs worth shrinking from the usage their kubelets report
+type Scan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespaces to scan, with namespace_selector every namespace it matches is scanned too
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// a label selector on namespaces e.g. down-pvscope.io/auto=true
+	NamespaceSelector string `protobuf:"bytes,2,opt,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty"`
+	// free space kept on top of the used bytes as a fraction of them, 0.25 when unset
+	Headroom float64 `protobuf:"fixed64,3,opt,name=headroom,proto3" json:"headroom,omitempty"`
+	// smaller savings aren't recommended, 1Gi when unset
+	MinReclaim string `protobuf:"bytes,4,opt,name=min_reclaim,json=minReclaim,proto3" json:"min_reclaim,omitempty"`
+	// suggested sizes fit the growth seen in the usage history over this long, 90 days when unset
+	GrowthHorizon *durationpb.Duration `protobuf:"bytes,5,opt,name=growth_horizon,json=growthHorizon,proto3" json:"growth_horizon,omitempty"`
+}
+
+func (x *Scan) Reset() {
+	*x = Scan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scan) ProtoMessage() {}
+
+func (x *Scan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scan.ProtoReflect.Descriptor instead.
+func (*Scan) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Scan) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *Scan) GetNamespaceSelector() string {
+	if x != nil {
+		return x.NamespaceSelector
+	}
+	return ""
+}
+
+func (x *Scan) GetHeadroom() float64 {
+	if x != nil {
+		return x.Headroom
+	}
+	return 0
+}
+
+func (x *Scan) GetMinReclaim() string {
+	if x != nil {
+		return x.MinReclaim
+	}
+	return ""
+}
+
+func (x *Scan) GetGrowthHorizon() *durationpb.Duration {
+	if x != nil {
+		return x.GrowthHorizon
+	}
+	return nil
+}
+
+type Recommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Pvc       string `protobuf:"bytes,2,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	// empty when no statefulset owns the pvc, those can't be resized
+	Sts              string `protobuf:"bytes,3,opt,name=sts,proto3" json:"sts,omitempty"`
+	CurrentSize      string `protobuf:"bytes,4,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	UsedBytes        int64  `protobuf:"varint,5,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	CapacityBytes    int64  `protobuf:"varint,6,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	InodesUsed       uint64 `protobuf:"varint,7,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
+	SuggestedSize    string `protobuf:"bytes,8,opt,name=suggested_size,json=suggestedSize,proto3" json:"suggested_size,omitempty"`
+	ReclaimableBytes int64  `protobuf:"varint,9,opt,name=reclaimable_bytes,json=reclaimableBytes,proto3" json:"reclaimable_bytes,omitempty"`
+	// the most the volume is expected to use within the growth horizon, what the suggested size is based on
+	ProjectedBytes    int64 `protobuf:"varint,10,opt,name=projected_bytes,json=projectedBytes,proto3" json:"projected_bytes,omitempty"`
+	GrowthBytesPerDay int64 `protobuf:"varint,11,opt,name=growth_bytes_per_day,json=growthBytesPerDay,proto3" json:"growth_bytes_per_day,omitempty"`
+	// usage history samples the projection is based on
+	Samples int32 `protobuf:"varint,12,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Recommendation) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Recommendation) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *Recommendation) GetSts() string {
+	if x != nil {
+		return x.Sts
+	}
+	return ""
+}
+
+func (x *Recommendation) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *Recommendation) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *Recommendation) GetCapacityBytes() int64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+func (x *Recommendation) GetInodesUsed() uint64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *Recommendation) GetSuggestedSize() string {
+	if x != nil {
+		return x.SuggestedSize
+	}
+	return ""
+}
+
+func (x *Recommendation) GetReclaimableBytes() int64 {
+	if x != nil {
+		return x.ReclaimableBytes
+	}
+	return 0
+}
+
+func (x *Recommendation) GetProjectedBytes() int64 {
+	if x != nil {
+		return x.ProjectedBytes
+	}
+	return 0
+}
+
+func (x *Recommendation) GetGrowthBytesPerDay() int64 {
+	if x != nil {
+		return x.GrowthBytesPerDay
+	}
+	return 0
+}
+
+func (x *Recommendation) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type ScanReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// largest reclaimable_bytes first
+	Recommendations []*Recommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Scanned         int32             `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	// namespace/pvc of claims no kubelet reported, because no running pod mounts them or their node's kubelet couldn't be reached
+	Unmeasured []string `protobuf:"bytes,3,rep,name=unmeasured,proto3" json:"unmeasured,omitempty"`
+}
+
+func (x *ScanReport) Reset() {
+	*x = ScanReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanReport) ProtoMessage() {}
+
+func (x *ScanReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanReport.ProtoReflect.Descriptor instead.
+func (*ScanReport) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScanReport) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *ScanReport) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *ScanReport) GetUnmeasured() []string {
+	if x != nil {
+		return x.Unmeasured
+	}
+	return nil
+}
+
+// Campaign scans for pvcs worth shrinking and acts on the ones using little of their size
+// it's meant to be started by a Temporal schedule
+type Campaign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scan *Scan `protobuf:"bytes,1,opt,name=scan,proto3" json:"scan,omitempty"`
+	// only pvcs using less than this fraction of their size are selected, every recommendation when unset
+	MaxUsage float64        `protobuf:"fixed64,2,opt,name=max_usage,json=maxUsage,proto3" json:"max_usage,omitempty"`
+	Action   CampaignAction `protobuf:"varint,3,opt,name=action,proto3,enum=workflows.scaler.v1.CampaignAction" json:"action,omitempty"`
+	// how long each resize waits for its approval before it's rejected, forever when unset
+	ApprovalTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=approval_timeout,json=approvalTimeout,proto3" json:"approval_timeout,omitempty"`
+	// the latest CampaignReport is kept in this ConfigMap as proto json, nothing is kept when empty
+	ReportNamespace string `protobuf:"bytes,5,opt,name=report_namespace,json=reportNamespace,proto3" json:"report_namespace,omitempty"`
+	ReportConfigmap string `protobuf:"bytes,6,opt,name=report_configmap,json=reportConfigmap,proto3" json:"report_configmap,omitempty"`
+}
+
+func (x *Campaign) Reset() {
+	*x = Campaign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Campaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Campaign) ProtoMessage() {}
+
+func (x *Campaign) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Campaign.ProtoReflect.Descriptor instead.
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Campaign) GetScan() *Scan {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+func (x *Campaign) GetMaxUsage() float64 {
+	if x != nil {
+		return x.MaxUsage
+	}
+	return 0
+}
+
+func (x *Campaign) GetAction() CampaignAction {
+	if x != nil {
+		return x.Action
+	}
+	return CampaignAction_CAMPAIGN_ACTION_REPORT
+}
+
+func (x *Campaign) GetApprovalTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ApprovalTimeout
+	}
+	return nil
+}
+
+func (x *Campaign) GetReportNamespace() string {
+	if x != nil {
+		return x.ReportNamespace
+	}
+	return ""
+}
+
+func (x *Campaign) GetReportConfigmap() string {
+	if x != nil {
+		return x.ReportConfigmap
+	}
+	return ""
+}
+
+type CampaignReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scan     *ScanReport       `protobuf:"bytes,1,opt,name=scan,proto3" json:"scan,omitempty"`
+	Selected []*Recommendation `protobuf:"bytes,2,rep,name=selected,proto3" json:"selected,omitempty"`
+	// ids of the resize workflows started
+	Started []string `protobuf:"bytes,3,rep,name=started,proto3" json:"started,omitempty"`
+	// <namespace>/<pvc>: why a selected pvc wasn't resized
+	Skipped    []string               `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *CampaignReport) Reset() {
+	*x = CampaignReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CampaignReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignReport) ProtoMessage() {}
+
+func (x *CampaignReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignReport.ProtoReflect.Descriptor instead.
+func (*CampaignReport) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CampaignReport) GetScan() *ScanReport {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+func (x *CampaignReport) GetSelected() []*Recommendation {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+func (x *CampaignReport) GetStarted() []string {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *CampaignReport) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *CampaignReport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// UsageCollection samples the used bytes of every mounted pvc into a usage history ConfigMap per namespace
+// it's meant to be started by a Temporal schedule
+type UsageCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces        []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	NamespaceSelector string   `protobuf:"bytes,2,opt,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty"`
+	// samples older than this are dropped, 180 days when unset
+	Retention *durationpb.Duration `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *UsageCollection) Reset() {
+	*x = UsageCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageCollection) ProtoMessage() {}
+
+func (x *UsageCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageCollection.ProtoReflect.Descriptor instead.
+func (*UsageCollection) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UsageCollection) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *UsageCollection) GetNamespaceSelector() string {
+	if x != nil {
+		return x.NamespaceSelector
+	}
+	return ""
+}
+
+func (x *UsageCollection) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+var File_api_down_pvscope_v1_down_pvscope_proto protoreflect.FileDescriptor
+
+var file_api_down_pvscope_v1_down_pvscope_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4,
+	0x07, 0x0a, 0x05, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x44,
+	0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x72, 0x65,
+	0x5f, 0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52,
+	0x0f, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73,
+	0x12, 0x45, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49,
+	0x0a, 0x13, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x70,
+	0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x70,
+	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x70, 0x79,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x70, 0x79, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x11, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x39, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a,
+	0x0e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9c, 0x07, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x6c, 0x64, 0x50, 0x76, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x76, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65, 0x77, 0x50, 0x76, 0x12, 0x2e, 0x0a, 0x13, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x76, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x4c, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x0a, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
+	0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x32, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x22, 0xf0, 0x01, 0x0a, 0x09, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
+	0x5d, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79,
+	0x61, 0x6d, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x81,
+	0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x22, 0x5b, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
+	0x62, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x22, 0x94, 0x04, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x70, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65,
+	0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70,
+	0x76, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x50, 0x76, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x70, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x50, 0x76, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x76, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x50, 0x76, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x50, 0x76, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x63, 0x6f, 0x70,
+	0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x70,
+	0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x65, 0x74, 0x61,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0f,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x73, 0x0a, 0x06, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x9e,
+	0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8a, 0x02, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69, 0x65,
+	0x73, 0x63, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f,
+	0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x3b, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x89, 0x02, 0x0a,
+	0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x31, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x62, 0x0a, 0x08, 0x48, 0x74, 0x74, 0x70,
+	0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0c,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x07, 0x50, 0x76, 0x63, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x76, 0x63, 0x53,
+	0x70, 0x65, 0x63, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x97, 0x02, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x11,
+	0x70, 0x72, 0x65, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x70,
+	0x76, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x76, 0x63, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x37, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x8a, 0x02, 0x0a, 0x06, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x53, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x12, 0x2e, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x76, 0x63, 0x53, 0x70, 0x65, 0x63, 0x52, 0x03, 0x70,
+	0x76, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xd3, 0x03, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x76, 0x63,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x72,
+	0x65, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0xfc, 0x02, 0x0a,
+	0x0c, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x37, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x45, 0x0a,
+	0x11, 0x70, 0x72, 0x65, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x48,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x05,
+	0x46, 0x6c, 0x65, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x70, 0x76, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x0b,
+	0x46, 0x6c, 0x65, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xd4, 0x01, 0x0a, 0x04, 0x53, 0x63, 0x61,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x40, 0x0a,
+	0x0e, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x77, 0x74, 0x68, 0x48, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x22,
+	0xa4, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70,
+	0x76, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x67,
+	0x72, 0x6f, 0x77, 0x74, 0x68, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x67, 0x72, 0x6f, 0x77, 0x74,
+	0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x75, 0x6e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x22, 0xaf,
+	0x02, 0x0a, 0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x73,
+	0x63, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x6d, 0x61, 0x70,
+	0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a,
+	0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x59, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x53, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53,
+	0x49, 0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x4d, 0x41, 0x50, 0x10,
+	0x02, 0x2a, 0x41, 0x0a, 0x08, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x50, 0x59, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f,
+	0x50, 0x59, 0x10, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x53, 0x53, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x52,
+	0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f,
+	0x52, 0x49, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x50, 0x56, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x11, 0x48, 0x6f, 0x6f, 0x6b, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x48,
+	0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43,
+	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10, 0x01, 0x2a, 0x59, 0x0a, 0x0b,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x43,
+	0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x49, 0x56, 0x45,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x51, 0x55, 0x49, 0x45, 0x53, 0x43, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
+	0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x4e, 0x41,
+	0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x02, 0x2a, 0x3f, 0x0a, 0x0a, 0x53, 0x65, 0x65, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x51, 0x55, 0x49, 0x45, 0x53, 0x43, 0x45, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x53, 0x45, 0x45, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x2a, 0x77, 0x0a, 0x11, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
+	0x1b, 0x46, 0x4c, 0x45, 0x45, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x46, 0x4c, 0x45, 0x45, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x4c, 0x45, 0x45, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x48, 0x0a, 0x0e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4d, 0x50, 0x41, 0x49, 0x47, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x5a, 0x45, 0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x72, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x66, 0x6d, 0x61, 0x6e, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_down_pvscope_v1_down_pvscope_proto_rawDescOnce sync.Once
+	file_api_down_pvscope_v1_down_pvscope_proto_rawDescData = file_api_down_pvscope_v1_down_pvscope_proto_rawDesc
+)
+
+func file_api_down_pvscope_v1_down_pvscope_proto_rawDescGZIP() []byte {
+	file_api_down_pvscope_v1_down_pvscope_proto_rawDescOnce.Do(func() {
+		file_api_down_pvscope_v1_down_pvscope_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_down_pvscope_v1_down_pvscope_proto_rawDescData)
+	})
+	return file_api_down_pvscope_v1_down_pvscope_proto_rawDescData
+}
+
+var file_api_down_pvscope_v1_down_pvscope_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_down_pvscope_v1_down_pvscope_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_api_down_pvscope_v1_down_pvscope_proto_goTypes = []interface{}{
+	(ResultSink)(0),               // 0: workflows.scaler.v1.ResultSink
+	(CopyMode)(0),                 // 1: workflows.scaler.v1.CopyMode
+	(SnapshotRetention)(0),        // 2: workflows.scaler.v1.SnapshotRetention
+	(RollbackSource)(0),           // 3: workflows.scaler.v1.RollbackSource
+	(HookFailurePolicy)(0),        // 4: workflows.scaler.v1.HookFailurePolicy
+	(CloneSource)(0),              // 5: workflows.scaler.v1.CloneSource
+	(SeedSource)(0),               // 6: workflows.scaler.v1.SeedSource
+	(FleetTargetStatus)(0),        // 7: workflows.scaler.v1.FleetTargetStatus
+	(CampaignAction)(0),           // 8: workflows.scaler.v1.CampaignAction
+	(*Scale)(nil),                 // 9: workflows.scaler.v1.Scale
+	(*ScaleResult)(nil),           // 10: workflows.scaler.v1.ScaleResult
+	(*ScalePlan)(nil),             // 11: workflows.scaler.v1.ScalePlan
+	(*PlanStep)(nil),              // 12: workflows.scaler.v1.PlanStep
+	(*Manifest)(nil),              // 13: workflows.scaler.v1.Manifest
+	(*VerificationSummary)(nil),   // 14: workflows.scaler.v1.VerificationSummary
+	(*Approval)(nil),              // 15: workflows.scaler.v1.Approval
+	(*ApprovalDecision)(nil),      // 16: workflows.scaler.v1.ApprovalDecision
+	(*MaintenanceWindow)(nil),     // 17: workflows.scaler.v1.MaintenanceWindow
+	(*ScaleStatus)(nil),           // 18: workflows.scaler.v1.ScaleStatus
+	(*StepTiming)(nil),            // 19: workflows.scaler.v1.StepTiming
+	(*CopyProgress)(nil),          // 20: workflows.scaler.v1.CopyProgress
+	(*PendingApproval)(nil),       // 21: workflows.scaler.v1.PendingApproval
+	(*Backup)(nil),                // 22: workflows.scaler.v1.Backup
+	(*Snapshot)(nil),              // 23: workflows.scaler.v1.Snapshot
+	(*Rollback)(nil),              // 24: workflows.scaler.v1.Rollback
+	(*Hook)(nil),                  // 25: workflows.scaler.v1.Hook
+	(*HttpHook)(nil),              // 26: workflows.scaler.v1.HttpHook
+	(*Verification)(nil),          // 27: workflows.scaler.v1.Verification
+	(*PvcSpec)(nil),               // 28: workflows.scaler.v1.PvcSpec
+	(*Export)(nil),                // 29: workflows.scaler.v1.Export
+	(*ExportResult)(nil),          // 30: workflows.scaler.v1.ExportResult
+	(*Import)(nil),                // 31: workflows.scaler.v1.Import
+	(*Clone)(nil),                 // 32: workflows.scaler.v1.Clone
+	(*SeedReplicas)(nil),          // 33: workflows.scaler.v1.SeedReplicas
+	(*Fleet)(nil),                 // 34: workflows.scaler.v1.Fleet
+	(*FleetOutcome)(nil),          // 35: workflows.scaler.v1.FleetOutcome
+	(*FleetReport)(nil),           // 36: workflows.scaler.v1.FleetReport
+	(*Scan)(nil),                  // 37: workflows.scaler.v1.Scan
+	(*Recommendation)(nil),        // 38: workflows.scaler.v1.Recommendation
+	(*ScanReport)(nil),            // 39: workflows.scaler.v1.ScanReport
+	(*Campaign)(nil),              // 40: workflows.scaler.v1.Campaign
+	(*CampaignReport)(nil),        // 41: workflows.scaler.v1.CampaignReport
+	(*UsageCollection)(nil),       // 42: workflows.scaler.v1.UsageCollection
+	nil,                           // 43: workflows.scaler.v1.PvcSpec.LabelsEntry
+	(*durationpb.Duration)(nil),   // 44: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 45: google.protobuf.Timestamp
+}
+var file_api_down_pvscope_v1_down_pvscope_proto_depIdxs = []int32{
+	25, // 0: workflows.scaler.v1.Scale.pre_quiesce_hooks:type_name -> workflows.scaler.v1.Hook
+	25, // 1: workflows.scaler.v1.Scale.post_resume_hooks:type_name -> workflows.scaler.v1.Hook
+	27, // 2: workflows.scaler.v1.Scale.verification:type_name -> workflows.scaler.v1.Verification
+	44, // 3: workflows.scaler.v1.Scale.retain_original_for:type_name -> google.protobuf.Duration
+	23, // 4: workflows.scaler.v1.Scale.snapshot:type_name -> workflows.scaler.v1.Snapshot
+	22, // 5: workflows.scaler.v1.Scale.backup:type_name -> workflows.scaler.v1.Backup
+	1,  // 6: workflows.scaler.v1.Scale.copy_mode:type_name -> workflows.scaler.v1.CopyMode
+	44, // 7: workflows.scaler.v1.Scale.max_downtime:type_name -> google.protobuf.Duration
+	17, // 8: workflows.scaler.v1.Scale.maintenance_window:type_name -> workflows.scaler.v1.MaintenanceWindow
+	15, // 9: workflows.scaler.v1.Scale.approval:type_name -> workflows.scaler.v1.Approval
+	0,  // 10: workflows.scaler.v1.Scale.persist_result:type_name -> workflows.scaler.v1.ResultSink
+	20, // 11: workflows.scaler.v1.ScaleResult.mover:type_name -> workflows.scaler.v1.CopyProgress
+	14, // 12: workflows.scaler.v1.ScaleResult.verification:type_name -> workflows.scaler.v1.VerificationSummary
+	44, // 13: workflows.scaler.v1.ScaleResult.downtime:type_name -> google.protobuf.Duration
+	44, // 14: workflows.scaler.v1.ScaleResult.estimated_downtime:type_name -> google.protobuf.Duration
+	45, // 15: workflows.scaler.v1.ScaleResult.started_at:type_name -> google.protobuf.Timestamp
+	45, // 16: workflows.scaler.v1.ScaleResult.finished_at:type_name -> google.protobuf.Timestamp
+	11, // 17: workflows.scaler.v1.ScaleResult.plan:type_name -> workflows.scaler.v1.ScalePlan
+	12, // 18: workflows.scaler.v1.ScalePlan.steps:type_name -> workflows.scaler.v1.PlanStep
+	44, // 19: workflows.scaler.v1.ScalePlan.expected_downtime:type_name -> google.protobuf.Duration
+	13, // 20: workflows.scaler.v1.ScalePlan.manifests:type_name -> workflows.scaler.v1.Manifest
+	44, // 21: workflows.scaler.v1.VerificationSummary.stable_for:type_name -> google.protobuf.Duration
+	44, // 22: workflows.scaler.v1.Approval.timeout:type_name -> google.protobuf.Duration
+	45, // 23: workflows.scaler.v1.ScaleStatus.step_started_at:type_name -> google.protobuf.Timestamp
+	19, // 24: workflows.scaler.v1.ScaleStatus.completed_steps:type_name -> workflows.scaler.v1.StepTiming
+	20, // 25: workflows.scaler.v1.ScaleStatus.copy_progress:type_name -> workflows.scaler.v1.CopyProgress
+	21, // 26: workflows.scaler.v1.ScaleStatus.pending_approval:type_name -> workflows.scaler.v1.PendingApproval
+	45, // 27: workflows.scaler.v1.StepTiming.started_at:type_name -> google.protobuf.Timestamp
+	45, // 28: workflows.scaler.v1.StepTiming.finished_at:type_name -> google.protobuf.Timestamp
+	44, // 29: workflows.scaler.v1.CopyProgress.eta:type_name -> google.protobuf.Duration
+	45, // 30: workflows.scaler.v1.CopyProgress.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 31: workflows.scaler.v1.PendingApproval.requested_at:type_name -> google.protobuf.Timestamp
+	45, // 32: workflows.scaler.v1.PendingApproval.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 33: workflows.scaler.v1.Snapshot.retention:type_name -> workflows.scaler.v1.SnapshotRetention
+	25, // 34: workflows.scaler.v1.Rollback.pre_quiesce_hooks:type_name -> workflows.scaler.v1.Hook
+	25, // 35: workflows.scaler.v1.Rollback.post_resume_hooks:type_name -> workflows.scaler.v1.Hook
+	3,  // 36: workflows.scaler.v1.Rollback.source:type_name -> workflows.scaler.v1.RollbackSource
+	26, // 37: workflows.scaler.v1.Hook.http:type_name -> workflows.scaler.v1.HttpHook
+	44, // 38: workflows.scaler.v1.Hook.timeout:type_name -> google.protobuf.Duration
+	4,  // 39: workflows.scaler.v1.Hook.failure_policy:type_name -> workflows.scaler.v1.HookFailurePolicy
+	44, // 40: workflows.scaler.v1.Verification.stable_for:type_name -> google.protobuf.Duration
+	25, // 41: workflows.scaler.v1.Verification.probe:type_name -> workflows.scaler.v1.Hook
+	43, // 42: workflows.scaler.v1.PvcSpec.labels:type_name -> workflows.scaler.v1.PvcSpec.LabelsEntry
+	22, // 43: workflows.scaler.v1.Export.destination:type_name -> workflows.scaler.v1.Backup
+	25, // 44: workflows.scaler.v1.Export.pre_quiesce_hooks:type_name -> workflows.scaler.v1.Hook
+	25, // 45: workflows.scaler.v1.Export.post_resume_hooks:type_name -> workflows.scaler.v1.Hook
+	28, // 46: workflows.scaler.v1.ExportResult.pvc:type_name -> workflows.scaler.v1.PvcSpec
+	22, // 47: workflows.scaler.v1.ExportResult.location:type_name -> workflows.scaler.v1.Backup
+	22, // 48: workflows.scaler.v1.Import.source:type_name -> workflows.scaler.v1.Backup
+	28, // 49: workflows.scaler.v1.Import.pvc:type_name -> workflows.scaler.v1.PvcSpec
+	5,  // 50: workflows.scaler.v1.Clone.source:type_name -> workflows.scaler.v1.CloneSource
+	25, // 51: workflows.scaler.v1.Clone.pre_quiesce_hooks:type_name -> workflows.scaler.v1.Hook
+	25, // 52: workflows.scaler.v1.Clone.post_resume_hooks:type_name -> workflows.scaler.v1.Hook
+	6,  // 53: workflows.scaler.v1.SeedReplicas.source:type_name -> workflows.scaler.v1.SeedSource
+	25, // 54: workflows.scaler.v1.SeedReplicas.pre_quiesce_hooks:type_name -> workflows.scaler.v1.Hook
+	25, // 55: workflows.scaler.v1.SeedReplicas.post_resume_hooks:type_name -> workflows.scaler.v1.Hook
+	9,  // 56: workflows.scaler.v1.Fleet.targets:type_name -> workflows.scaler.v1.Scale
+	35, // 57: workflows.scaler.v1.Fleet.outcomes:type_name -> workflows.scaler.v1.FleetOutcome
+	7,  // 58: workflows.scaler.v1.FleetOutcome.status:type_name -> workflows.scaler.v1.FleetTargetStatus
+	10, // 59: workflows.scaler.v1.FleetOutcome.result:type_name -> workflows.scaler.v1.ScaleResult
+	35, // 60: workflows.scaler.v1.FleetReport.outcomes:type_name -> workflows.scaler.v1.FleetOutcome
+	44, // 61: workflows.scaler.v1.Scan.growth_horizon:type_name -> google.protobuf.Duration
+	38, // 62: workflows.scaler.v1.ScanReport.recommendations:type_name -> workflows.scaler.v1.Recommendation
+	37, // 63: workflows.scaler.v1.Campaign.scan:type_name -> workflows.scaler.v1.Scan
+	8,  // 64: workflows.scaler.v1.Campaign.action:type_name -> workflows.scaler.v1.CampaignAction
+	44, // 65: workflows.scaler.v1.Campaign.approval_timeout:type_name -> google.protobuf.Duration
+	39, // 66: workflows.scaler.v1.CampaignReport.scan:type_name -> workflows.scaler.v1.ScanReport
+	38, // 67: workflows.scaler.v1.CampaignReport.selected:type_name -> workflows.scaler.v1.Recommendation
+	45, // 68: workflows.scaler.v1.CampaignReport.finished_at:type_name -> google.protobuf.Timestamp
+	44, // 69: workflows.scaler.v1.UsageCollection.retention:type_name -> google.protobuf.Duration
+	70, // [70:70] is the sub-list for method output_type
+	70, // [70:70] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
+}
+
+func init() { file_api_down_pvscope_v1_down_pvscope_proto_init() }
+func file_api_down_pvscope_v1_down_pvscope_proto_init() {
+	if File_api_down_pvscope_v1_down_pvscope_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScaleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalePlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Manifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Approval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApprovalDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScaleStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepTiming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Backup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpHook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Verification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PvcSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Export); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Import); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedReplicas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fleet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetOutcome); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recommendation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Campaign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CampaignReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v1_down_pvscope_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -158,13 +4709,14 @@ func file_api_down_pvscope_v1_down_pvscope_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_down_pvscope_v1_down_pvscope_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      9,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_down_pvscope_v1_down_pvscope_proto_goTypes,
 		DependencyIndexes: file_api_down_pvscope_v1_down_pvscope_proto_depIdxs,
+		EnumInfos:         file_api_down_pvscope_v1_down_pvscope_proto_enumTypes,
 		MessageInfos:      file_api_down_pvscope_v1_down_pvscope_proto_msgTypes,
 	}.Build()
 	File_api_down_pvscope_v1_down_pvscope_proto = out.File
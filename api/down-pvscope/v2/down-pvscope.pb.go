@@ -0,0 +1,938 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: api/down-pvscope/v2/down-pvscope.proto
+
+package v2
+
+import (
+	v1 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScaleRequest shrinks the pvc of a statefulset
+// it is validated before anything is touched - v1 Scale payloads are converted to it
+type ScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workload *WorkloadRef `protobuf:"bytes,1,opt,name=workload,proto3" json:"workload,omitempty"`
+	Target   *Target      `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Options  *Options     `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// run the preflight checks and report what would happen without changing anything
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ScaleRequest) Reset() {
+	*x = ScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleRequest) ProtoMessage() {}
+
+func (x *ScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleRequest.ProtoReflect.Descriptor instead.
+func (*ScaleRequest) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScaleRequest) GetWorkload() *WorkloadRef {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *ScaleRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ScaleRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ScaleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// WorkloadRef is the statefulset mounting the pvc
+type WorkloadRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Statefulset string `protobuf:"bytes,2,opt,name=statefulset,proto3" json:"statefulset,omitempty"`
+}
+
+func (x *WorkloadRef) Reset() {
+	*x = WorkloadRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkloadRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadRef) ProtoMessage() {}
+
+func (x *WorkloadRef) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadRef.ProtoReflect.Descriptor instead.
+func (*WorkloadRef) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkloadRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WorkloadRef) GetStatefulset() string {
+	if x != nil {
+		return x.Statefulset
+	}
+	return ""
+}
+
+type Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pvc string `protobuf:"bytes,1,opt,name=pvc,proto3" json:"pvc,omitempty"`
+	// kubernetes quantity smaller than the current size e.g. "10Gi"
+	Size string `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Target) GetPvc() string {
+	if x != nil {
+		return x.Pvc
+	}
+	return ""
+}
+
+func (x *Target) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Safety       *SafetyOptions    `protobuf:"bytes,1,opt,name=safety,proto3" json:"safety,omitempty"`
+	Hooks        *HookOptions      `protobuf:"bytes,2,opt,name=hooks,proto3" json:"hooks,omitempty"`
+	Mover        *MoverOptions     `protobuf:"bytes,3,opt,name=mover,proto3" json:"mover,omitempty"`
+	Verification *v1.Verification  `protobuf:"bytes,4,opt,name=verification,proto3" json:"verification,omitempty"`
+	Retention    *RetentionOptions `protobuf:"bytes,5,opt,name=retention,proto3" json:"retention,omitempty"`
+	Timeouts     *Timeouts         `protobuf:"bytes,6,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Options) GetSafety() *SafetyOptions {
+	if x != nil {
+		return x.Safety
+	}
+	return nil
+}
+
+func (x *Options) GetHooks() *HookOptions {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *Options) GetMover() *MoverOptions {
+	if x != nil {
+		return x.Mover
+	}
+	return nil
+}
+
+func (x *Options) GetVerification() *v1.Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *Options) GetRetention() *RetentionOptions {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Options) GetTimeouts() *Timeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+type SafetyOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scale to 0 even if it violates a PodDisruptionBudget - every override is audited
+	AllowDisruption bool `protobuf:"varint,1,opt,name=allow_disruption,json=allowDisruption,proto3" json:"allow_disruption,omitempty"`
+	// refuse to start (or abort the copy) when the sts would be at 0 for longer
+	MaxDowntime       *durationpb.Duration  `protobuf:"bytes,2,opt,name=max_downtime,json=maxDowntime,proto3" json:"max_downtime,omitempty"`
+	MaintenanceWindow *v1.MaintenanceWindow `protobuf:"bytes,3,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	Approval          *v1.Approval          `protobuf:"bytes,4,opt,name=approval,proto3" json:"approval,omitempty"`
+	Breakpoints       []string              `protobuf:"bytes,5,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
+}
+
+func (x *SafetyOptions) Reset() {
+	*x = SafetyOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyOptions) ProtoMessage() {}
+
+func (x *SafetyOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyOptions.ProtoReflect.Descriptor instead.
+func (*SafetyOptions) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SafetyOptions) GetAllowDisruption() bool {
+	if x != nil {
+		return x.AllowDisruption
+	}
+	return false
+}
+
+func (x *SafetyOptions) GetMaxDowntime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDowntime
+	}
+	return nil
+}
+
+func (x *SafetyOptions) GetMaintenanceWindow() *v1.MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+func (x *SafetyOptions) GetApproval() *v1.Approval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *SafetyOptions) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+type HookOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fall back to the down-pvscope.io/pre-hook and down-pvscope.io/post-hook annotations when empty
+	PreQuiesce []*v1.Hook `protobuf:"bytes,1,rep,name=pre_quiesce,json=preQuiesce,proto3" json:"pre_quiesce,omitempty"`
+	PostResume []*v1.Hook `protobuf:"bytes,2,rep,name=post_resume,json=postResume,proto3" json:"post_resume,omitempty"`
+}
+
+func (x *HookOptions) Reset() {
+	*x = HookOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HookOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookOptions) ProtoMessage() {}
+
+func (x *HookOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookOptions.ProtoReflect.Descriptor instead.
+func (*HookOptions) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HookOptions) GetPreQuiesce() []*v1.Hook {
+	if x != nil {
+		return x.PreQuiesce
+	}
+	return nil
+}
+
+func (x *HookOptions) GetPostResume() []*v1.Hook {
+	if x != nil {
+		return x.PostResume
+	}
+	return nil
+}
+
+type MoverOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CopyMode v1.CopyMode `protobuf:"varint,1,opt,name=copy_mode,json=copyMode,proto3,enum=workflows.scaler.v1.CopyMode" json:"copy_mode,omitempty"`
+	// class of the pre-copy snapshot in COPY_MODE_SNAPSHOT_PRECOPY - the default class when empty
+	PrecopySnapshotClass string `protobuf:"bytes,2,opt,name=precopy_snapshot_class,json=precopySnapshotClass,proto3" json:"precopy_snapshot_class,omitempty"`
+}
+
+func (x *MoverOptions) Reset() {
+	*x = MoverOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoverOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoverOptions) ProtoMessage() {}
+
+func (x *MoverOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoverOptions.ProtoReflect.Descriptor instead.
+func (*MoverOptions) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MoverOptions) GetCopyMode() v1.CopyMode {
+	if x != nil {
+		return x.CopyMode
+	}
+	return v1.CopyMode(0)
+}
+
+func (x *MoverOptions) GetPrecopySnapshotClass() string {
+	if x != nil {
+		return x.PrecopySnapshotClass
+	}
+	return ""
+}
+
+type RetentionOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delete the original pv this long after a successful resize - unset keeps it forever
+	RetainOriginalFor *durationpb.Duration `protobuf:"bytes,1,opt,name=retain_original_for,json=retainOriginalFor,proto3" json:"retain_original_for,omitempty"`
+	Snapshot          *v1.Snapshot         `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Backup            *v1.Backup           `protobuf:"bytes,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	PersistResult     v1.ResultSink        `protobuf:"varint,4,opt,name=persist_result,json=persistResult,proto3,enum=workflows.scaler.v1.ResultSink" json:"persist_result,omitempty"`
+}
+
+func (x *RetentionOptions) Reset() {
+	*x = RetentionOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionOptions) ProtoMessage() {}
+
+func (x *RetentionOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionOptions.ProtoReflect.Descriptor instead.
+func (*RetentionOptions) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetentionOptions) GetRetainOriginalFor() *durationpb.Duration {
+	if x != nil {
+		return x.RetainOriginalFor
+	}
+	return nil
+}
+
+func (x *RetentionOptions) GetSnapshot() *v1.Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *RetentionOptions) GetBackup() *v1.Backup {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+func (x *RetentionOptions) GetPersistResult() v1.ResultSink {
+	if x != nil {
+		return x.PersistResult
+	}
+	return v1.ResultSink(0)
+}
+
+type Timeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// longest a copy job may run - 30m when unset
+	Copy *durationpb.Duration `protobuf:"bytes,1,opt,name=copy,proto3" json:"copy,omitempty"`
+	// applies to every hook that doesn't set its own timeout - 1m when unset
+	Hook *durationpb.Duration `protobuf:"bytes,2,opt,name=hook,proto3" json:"hook,omitempty"`
+}
+
+func (x *Timeouts) Reset() {
+	*x = Timeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timeouts) ProtoMessage() {}
+
+func (x *Timeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timeouts.ProtoReflect.Descriptor instead.
+func (*Timeouts) Descriptor() ([]byte, []int) {
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Timeouts) GetCopy() *durationpb.Duration {
+	if x != nil {
+		return x.Copy
+	}
+	return nil
+}
+
+func (x *Timeouts) GetHook() *durationpb.Duration {
+	if x != nil {
+		return x.Hook
+	}
+	return nil
+}
+
+var File_api_down_pvscope_v2_down_pvscope_proto protoreflect.FileDescriptor
+
+var file_api_down_pvscope_v2_down_pvscope_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x61,
+	0x70, 0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x66, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4d, 0x0a, 0x0b, 0x57, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x66, 0x75, 0x6c, 0x73, 0x65, 0x74, 0x22, 0x2e, 0x0a, 0x06, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x76, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x76, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xfd, 0x02, 0x0a, 0x07, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x12, 0x36, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6d, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x45, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0xac, 0x02, 0x0a, 0x0d, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x69, 0x73, 0x72,
+	0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6f,
+	0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x48, 0x6f, 0x6f,
+	0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f,
+	0x71, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x51, 0x75, 0x69,
+	0x65, 0x73, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x22, 0x80, 0x01, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x61,
+	0x69, 0x6e, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x11, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x46, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x33,
+	0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x46, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x52, 0x0d, 0x70, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x08, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x63, 0x6f, 0x70, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x61, 0x72, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x66, 0x6d, 0x61, 0x6e,
+	0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x70, 0x76, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2f, 0x76,
+	0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_down_pvscope_v2_down_pvscope_proto_rawDescOnce sync.Once
+	file_api_down_pvscope_v2_down_pvscope_proto_rawDescData = file_api_down_pvscope_v2_down_pvscope_proto_rawDesc
+)
+
+func file_api_down_pvscope_v2_down_pvscope_proto_rawDescGZIP() []byte {
+	file_api_down_pvscope_v2_down_pvscope_proto_rawDescOnce.Do(func() {
+		file_api_down_pvscope_v2_down_pvscope_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_down_pvscope_v2_down_pvscope_proto_rawDescData)
+	})
+	return file_api_down_pvscope_v2_down_pvscope_proto_rawDescData
+}
+
+var file_api_down_pvscope_v2_down_pvscope_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_down_pvscope_v2_down_pvscope_proto_goTypes = []interface{}{
+	(*ScaleRequest)(nil),         // 0: workflows.scaler.v2.ScaleRequest
+	(*WorkloadRef)(nil),          // 1: workflows.scaler.v2.WorkloadRef
+	(*Target)(nil),               // 2: workflows.scaler.v2.Target
+	(*Options)(nil),              // 3: workflows.scaler.v2.Options
+	(*SafetyOptions)(nil),        // 4: workflows.scaler.v2.SafetyOptions
+	(*HookOptions)(nil),          // 5: workflows.scaler.v2.HookOptions
+	(*MoverOptions)(nil),         // 6: workflows.scaler.v2.MoverOptions
+	(*RetentionOptions)(nil),     // 7: workflows.scaler.v2.RetentionOptions
+	(*Timeouts)(nil),             // 8: workflows.scaler.v2.Timeouts
+	(*v1.Verification)(nil),      // 9: workflows.scaler.v1.Verification
+	(*durationpb.Duration)(nil),  // 10: google.protobuf.Duration
+	(*v1.MaintenanceWindow)(nil), // 11: workflows.scaler.v1.MaintenanceWindow
+	(*v1.Approval)(nil),          // 12: workflows.scaler.v1.Approval
+	(*v1.Hook)(nil),              // 13: workflows.scaler.v1.Hook
+	(v1.CopyMode)(0),             // 14: workflows.scaler.v1.CopyMode
+	(*v1.Snapshot)(nil),          // 15: workflows.scaler.v1.Snapshot
+	(*v1.Backup)(nil),            // 16: workflows.scaler.v1.Backup
+	(v1.ResultSink)(0),           // 17: workflows.scaler.v1.ResultSink
+}
+var file_api_down_pvscope_v2_down_pvscope_proto_depIdxs = []int32{
+	1,  // 0: workflows.scaler.v2.ScaleRequest.workload:type_name -> workflows.scaler.v2.WorkloadRef
+	2,  // 1: workflows.scaler.v2.ScaleRequest.target:type_name -> workflows.scaler.v2.Target
+	3,  // 2: workflows.scaler.v2.ScaleRequest.options:type_name -> workflows.scaler.v2.Options
+	4,  // 3: workflows.scaler.v2.Options.safety:type_name -> workflows.scaler.v2.SafetyOptions
+	5,  // 4: workflows.scaler.v2.Options.hooks:type_name -> workflows.scaler.v2.HookOptions
+	6,  // 5: workflows.scaler.v2.Options.mover:type_name -> workflows.scaler.v2.MoverOptions
+	9,  // 6: workflows.scaler.v2.Options.verification:type_name -> workflows.scaler.v1.Verification
+	7,  // 7: workflows.scaler.v2.Options.retention:type_name -> workflows.scaler.v2.RetentionOptions
+	8,  // 8: workflows.scaler.v2.Options.timeouts:type_name -> workflows.scaler.v2.Timeouts
+	10, // 9: workflows.scaler.v2.SafetyOptions.max_downtime:type_name -> google.protobuf.Duration
+	11, // 10: workflows.scaler.v2.SafetyOptions.maintenance_window:type_name -> workflows.scaler.v1.MaintenanceWindow
+	12, // 11: workflows.scaler.v2.SafetyOptions.approval:type_name -> workflows.scaler.v1.Approval
+	13, // 12: workflows.scaler.v2.HookOptions.pre_quiesce:type_name -> workflows.scaler.v1.Hook
+	13, // 13: workflows.scaler.v2.HookOptions.post_resume:type_name -> workflows.scaler.v1.Hook
+	14, // 14: workflows.scaler.v2.MoverOptions.copy_mode:type_name -> workflows.scaler.v1.CopyMode
+	10, // 15: workflows.scaler.v2.RetentionOptions.retain_original_for:type_name -> google.protobuf.Duration
+	15, // 16: workflows.scaler.v2.RetentionOptions.snapshot:type_name -> workflows.scaler.v1.Snapshot
+	16, // 17: workflows.scaler.v2.RetentionOptions.backup:type_name -> workflows.scaler.v1.Backup
+	17, // 18: workflows.scaler.v2.RetentionOptions.persist_result:type_name -> workflows.scaler.v1.ResultSink
+	10, // 19: workflows.scaler.v2.Timeouts.copy:type_name -> google.protobuf.Duration
+	10, // 20: workflows.scaler.v2.Timeouts.hook:type_name -> google.protobuf.Duration
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_api_down_pvscope_v2_down_pvscope_proto_init() }
+func file_api_down_pvscope_v2_down_pvscope_proto_init() {
+	if File_api_down_pvscope_v2_down_pvscope_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkloadRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HookOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoverOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_down_pvscope_v2_down_pvscope_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_down_pvscope_v2_down_pvscope_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_down_pvscope_v2_down_pvscope_proto_goTypes,
+		DependencyIndexes: file_api_down_pvscope_v2_down_pvscope_proto_depIdxs,
+		MessageInfos:      file_api_down_pvscope_v2_down_pvscope_proto_msgTypes,
+	}.Build()
+	File_api_down_pvscope_v2_down_pvscope_proto = out.File
+	file_api_down_pvscope_v2_down_pvscope_proto_rawDesc = nil
+	file_api_down_pvscope_v2_down_pvscope_proto_goTypes = nil
+	file_api_down_pvscope_v2_down_pvscope_proto_depIdxs = nil
+}
@@ -4,64 +4,85 @@ import (
 	"context"
 	"log"
 	"os"
+	// the scratch image has no zoneinfo for maintenance window timezones
+	_ "time/tzdata"
 
 	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/ctl"
 	"github.com/aaronshifman/down-pvscope/pkg/workflows"
 	"github.com/urfave/cli/v3"
-	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 )
 
 func main() {
 	cmd := &cli.Command{
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:     "temporal-url",
-				Required: true,
+		Flags: ctl.Flags(),
+		Commands: append([]*cli.Command{
+			{
+				Name:   "worker",
+				Usage:  "run the temporal worker",
+				Action: runWorker,
 			},
-			&cli.StringFlag{
-				Name:     "temporal-namespace",
-				Required: true,
-			},
-		},
-		Action: func(ctx context.Context, cmd *cli.Command) error {
-			temporal := cmd.String("temporal-url")
-			temporalNamespace := cmd.String("temporal-namespace")
-
-			c, err := client.Dial(client.Options{
-				HostPort:  temporal,
-				Namespace: temporalNamespace,
-			})
-			if err != nil {
-				log.Fatalln("Unable to create Temporal client", err)
-			}
-			defer c.Close()
+		}, ctl.Commands()...),
+	}
+	if err := cmd.Run(context.Background(), os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
 
-			// Create the Temporal worker
-			w := worker.New(c, workflows.TaskQueueName, worker.Options{})
+func runWorker(ctx context.Context, cmd *cli.Command) error {
+	c, err := ctl.Dial(cmd)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer c.Close()
 
-			pvcActivities := &activities.PVCActivities{}
-			pvActivities := &activities.PVActivities{}
-			jobActivities := &activities.JobActivities{}
-			stsAcitivies := &activities.STSActivities{}
+	// Create the Temporal worker
+	w := worker.New(c, workflows.TaskQueueName, worker.Options{})
 
-			// Register Workflow and Activities
-			w.RegisterWorkflow(workflows.ScaleDownWorkflow)
-			w.RegisterActivity(pvcActivities)
-			w.RegisterActivity(pvActivities)
-			w.RegisterActivity(jobActivities)
-			w.RegisterActivity(stsAcitivies)
+	pvcActivities := &activities.PVCActivities{}
+	pvActivities := &activities.PVActivities{}
+	jobActivities := &activities.JobActivities{}
+	stsAcitivies := &activities.STSActivities{}
+	hookActivities := &activities.HookActivities{}
+	workflowActivities := &activities.WorkflowActivities{Client: c}
+	snapshotActivities := &activities.SnapshotActivities{}
+	namespaceActivities := &activities.NamespaceActivities{}
+	resultActivities := &activities.ResultActivities{}
+	planActivities := &activities.PlanActivities{}
+	scanActivities := &activities.ScanActivities{}
+	usageActivities := &activities.UsageActivities{}
 
-			// Start the Worker
-			err = w.Run(worker.InterruptCh())
-			if err != nil {
-				log.Fatalln("Unable to start Temporal worker", err)
-			}
+	// Register Workflow and Activities
+	w.RegisterWorkflow(workflows.ScaleDownWorkflow)
+	w.RegisterWorkflow(workflows.ScaleWorkflow)
+	w.RegisterWorkflow(workflows.RollbackWorkflow)
+	w.RegisterWorkflow(workflows.ExportPVCWorkflow)
+	w.RegisterWorkflow(workflows.ImportPVCWorkflow)
+	w.RegisterWorkflow(workflows.CloneWorkflow)
+	w.RegisterWorkflow(workflows.SeedReplicasWorkflow)
+	w.RegisterWorkflow(workflows.FleetResizeWorkflow)
+	w.RegisterWorkflow(workflows.ScanWorkflow)
+	w.RegisterWorkflow(workflows.CampaignWorkflow)
+	w.RegisterWorkflow(workflows.UsageCollectorWorkflow)
+	w.RegisterActivity(pvcActivities)
+	w.RegisterActivity(pvActivities)
+	w.RegisterActivity(jobActivities)
+	w.RegisterActivity(stsAcitivies)
+	w.RegisterActivity(hookActivities)
+	w.RegisterActivity(workflowActivities)
+	w.RegisterActivity(snapshotActivities)
+	w.RegisterActivity(namespaceActivities)
+	w.RegisterActivity(resultActivities)
+	w.RegisterActivity(planActivities)
+	w.RegisterActivity(scanActivities)
+	w.RegisterActivity(usageActivities)
 
-			return nil
-		},
-	}
-	if err := cmd.Run(context.Background(), os.Args); err != nil {
-		log.Fatal(err)
+	// Start the Worker
+	err = w.Run(worker.InterruptCh())
+	if err != nil {
+		log.Fatalln("Unable to start Temporal worker", err)
 	}
+
+	return nil
 }
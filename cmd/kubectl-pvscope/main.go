@@ -0,0 +1,277 @@
+// kubectl-pvscope is a kubectl plugin that starts and tracks resizes for the current kube context
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/ctl"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v3"
+	"go.temporal.io/sdk/client"
+)
+
+func main() {
+	cmd := &cli.Command{
+		Name:  "kubectl-pvscope",
+		Usage: "shrink statefulset pvcs with down-pvscope",
+		Flags: append(ctl.Flags(),
+			&cli.StringFlag{
+				Name: "kubeconfig",
+			},
+			&cli.StringFlag{
+				Name: "context",
+			},
+			&cli.StringFlag{
+				Name:    "namespace",
+				Aliases: []string{"n"},
+			},
+		),
+		Commands: []*cli.Command{
+			{
+				Name:      "shrink",
+				Usage:     "check, then resize a pvc and follow its progress",
+				ArgsUsage: "pvc/NAME",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "to",
+						Usage:    "the new size",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "sts",
+						Usage: "the statefulset using the pvc, found from its volumeClaimTemplates by default",
+					},
+					&cli.BoolFlag{
+						Name: "allow-disruption",
+					},
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "print the plan instead of resizing",
+					},
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "don't ask for confirmation",
+					},
+					&cli.BoolFlag{
+						Name:  "detach",
+						Usage: "return once the resize is started",
+					},
+				},
+				Action: shrink,
+			},
+			{
+				Name:      "status",
+				Usage:     "show the progress of a pvc's resize, or list the namespace's resizes",
+				ArgsUsage: "[pvc/NAME]",
+				Action:    withClaim(false, status),
+			},
+			{
+				Name:      "watch",
+				Usage:     "follow a pvc's resize until it finishes",
+				ArgsUsage: "pvc/NAME",
+				Action:    withClaim(true, watch),
+			},
+			{
+				Name:      "cancel",
+				Usage:     "cancel a pvc's resize",
+				ArgsUsage: "pvc/NAME",
+				Action:    withClaim(true, cancel),
+			},
+			{
+				Name:      "approve",
+				Usage:     "approve (or --reject) a pvc's resize waiting for approval",
+				ArgsUsage: "pvc/NAME",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "approver",
+						Sources: cli.EnvVars("USER"),
+					},
+					&cli.StringFlag{
+						Name: "reason",
+					},
+					&cli.BoolFlag{
+						Name: "reject",
+					},
+				},
+				Action: withClaim(true, approve),
+			},
+		},
+	}
+	if err := cmd.Run(context.Background(), os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func kubeFlags(cmd *cli.Command) ctl.KubeFlags {
+	return ctl.KubeFlags{
+		Kubeconfig: cmd.String("kubeconfig"),
+		Context:    cmd.String("context"),
+		Namespace:  cmd.String("namespace"),
+	}
+}
+
+// claimAction runs against the resize of a pvc - workflowID is empty when no pvc was given
+type claimAction func(ctx context.Context, cmd *cli.Command, c client.Client, ns, workflowID string) error
+
+func withClaim(required bool, action claimAction) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		if required && cmd.Args().Len() != 1 {
+			return errors.Errorf("%s needs exactly one pvc", cmd.Name)
+		}
+		_, ns, err := kubeFlags(cmd).Connect()
+		if err != nil {
+			return err
+		}
+
+		workflowID := ""
+		if cmd.Args().Present() {
+			pvc, err := ctl.ParseClaimRef(cmd.Args().First())
+			if err != nil {
+				return err
+			}
+			workflowID = ctl.WorkflowID(ns, pvc)
+		}
+
+		c, err := ctl.Dial(cmd)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		return action(ctx, cmd, c, ns, workflowID)
+	}
+}
+
+// nolint: funlen
+func shrink(ctx context.Context, cmd *cli.Command) error {
+	if cmd.Args().Len() != 1 {
+		return errors.New("shrink needs exactly one pvc")
+	}
+	pvc, err := ctl.ParseClaimRef(cmd.Args().First())
+	if err != nil {
+		return err
+	}
+	kube, ns, err := kubeFlags(cmd).Connect()
+	if err != nil {
+		return err
+	}
+
+	preflight, err := ctl.RunPreflight(ctx, kube, ns, pvc, cmd.String("sts"), cmd.String("to"))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(cmd.Writer, ctl.FormatPreflight(preflight))
+	if len(preflight.Problems) > 0 {
+		return errors.New("preflight failed")
+	}
+	if len(preflight.Violations) > 0 && !cmd.Bool("allow-disruption") {
+		return errors.New("scaling to 0 violates a PodDisruptionBudget, pass --allow-disruption to do it anyway")
+	}
+
+	dryRun := cmd.Bool("dry-run")
+	if !dryRun && !cmd.Bool("yes") {
+		_, _ = fmt.Fprint(cmd.Writer, "Resize? [y/N] ")
+		answer, _ := bufio.NewReader(cmd.Reader).ReadString('\n')
+		if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+			return errors.New("aborted")
+		}
+	}
+
+	c, err := ctl.Dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	run, err := ctl.Start(ctx, c, &protov2.ScaleRequest{
+		Workload: &protov2.WorkloadRef{Namespace: ns, Statefulset: preflight.STS},
+		Target:   &protov2.Target{Pvc: pvc, Size: cmd.String("to")},
+		Options: &protov2.Options{
+			Safety: &protov2.SafetyOptions{AllowDisruption: cmd.Bool("allow-disruption")},
+		},
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Started %s\n", run.GetID())
+	if cmd.Bool("detach") && !dryRun {
+		return nil
+	}
+
+	result, err := ctl.Watch(ctx, c, run.GetID(), 5*time.Second, cmd.Writer)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		for _, step := range result.GetPlan().GetSteps() {
+			_, _ = fmt.Fprintf(cmd.Writer, "%s: %s\n", step.Name, step.Detail)
+		}
+		return nil
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Resized %s/%s from %s to %s in %s at 0 replicas\n",
+		result.Namespace, result.Pvc, result.OldSize, result.NewSize, result.GetDowntime().AsDuration())
+	return nil
+}
+
+func status(ctx context.Context, cmd *cli.Command, c client.Client, ns, workflowID string) error {
+	if workflowID != "" {
+		s, err := ctl.Status(ctx, c, workflowID)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(cmd.Writer, ctl.FormatStatus(s))
+		return nil
+	}
+
+	summaries, err := ctl.List(ctx, c, 24*time.Hour)
+	if err != nil {
+		return err
+	}
+	inNamespace := []ctl.Summary{}
+	for _, s := range summaries {
+		if strings.HasPrefix(s.WorkflowID, ctl.WorkflowID(ns, "")) {
+			inNamespace = append(inNamespace, s)
+		}
+	}
+	_, _ = fmt.Fprint(cmd.Writer, ctl.FormatList(inNamespace))
+	return nil
+}
+
+func watch(ctx context.Context, cmd *cli.Command, c client.Client, _, workflowID string) error {
+	result, err := ctl.Watch(ctx, c, workflowID, 5*time.Second, cmd.Writer)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Resized %s/%s from %s to %s\n", result.Namespace, result.Pvc, result.OldSize, result.NewSize)
+	return nil
+}
+
+func cancel(ctx context.Context, cmd *cli.Command, c client.Client, _, workflowID string) error {
+	if err := ctl.Cancel(ctx, c, workflowID); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Requested cancellation of %s\n", workflowID)
+	return nil
+}
+
+func approve(ctx context.Context, cmd *cli.Command, c client.Client, _, workflowID string) error {
+	decision := &proto.ApprovalDecision{
+		Approved: !cmd.Bool("reject"),
+		Approver: cmd.String("approver"),
+		Reason:   cmd.String("reason"),
+	}
+	if err := ctl.Approve(ctx, c, workflowID, decision); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Sent decision approved=%t approver=%s\n", decision.Approved, decision.Approver)
+	return nil
+}
@@ -0,0 +1,164 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type HookActivities struct{}
+
+// DefaultHookTimeout applies to each pod a hook runs in when the hook doesn't set one
+const DefaultHookTimeout = time.Minute
+
+// keep the workflow history small if a hook is chatty
+const maxHookOutput = 64 * 1024
+
+// HookResult is the outcome of a single hook in a single pod
+// hook failures are reported through Error rather than failing the activity so the output ends up in the history
+type HookResult struct {
+	Hook   string `json:"hook"`
+	Pod    string `json:"pod"`
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+	Error  string `json:"error"`
+}
+
+// GetHookAnnotations returns the hook annotations set on the statefulset (if any)
+func (a *HookActivities) GetHookAnnotations(ctx context.Context, ns, sts string) (map[string]string, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := k8s.GetSTSAnnotations(ctx, client, ns, sts)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := map[string]string{}
+	for _, key := range []string{util.PreHookAnnotation, util.PostHookAnnotation} {
+		if value, ok := annotations[key]; ok {
+			hooks[key] = value
+		}
+	}
+	return hooks, nil
+}
+
+// ListHookTargets returns the running pods of the statefulset
+func (a *HookActivities) ListHookTargets(ctx context.Context, ns, sts string) ([]string, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := k8s.GetSTSPods(ctx, client, ns, sts)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			names = append(names, pod.Name)
+		}
+	}
+	return names, nil
+}
+
+func (a *HookActivities) RunHook(ctx context.Context, ns, podName string, hook *proto.Hook) (*HookResult, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := client.CoreV1().Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to get hook pod")
+	}
+
+	timeout := DefaultHookTimeout
+	if hook.Timeout != nil {
+		timeout = hook.Timeout.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := &HookResult{Hook: util.HookName(hook), Pod: podName}
+	slog.InfoContext(ctx, "Running hook", "hook", result.Hook, "pod", podName)
+
+	var stdout, stderr string
+	if hook.Http != nil {
+		stdout, err = runHTTPHook(ctx, pod, hook.Http)
+	} else {
+		cfg, cfgErr := util.GetRestConfig()
+		if cfgErr != nil {
+			return nil, cfgErr
+		}
+
+		container := hook.Container
+		if container == "" && len(pod.Spec.Containers) > 0 {
+			container = pod.Spec.Containers[0].Name
+		}
+		stdout, stderr, err = k8s.ExecInPod(ctx, client, cfg, ns, podName, container, hook.Command)
+	}
+
+	result.Stdout = truncate(stdout)
+	result.Stderr = truncate(stderr)
+	if err != nil {
+		slog.WarnContext(ctx, "Hook failed", "hook", result.Hook, "pod", podName, "error", err)
+		result.Error = err.Error()
+	}
+	return result, nil
+}
+
+func runHTTPHook(ctx context.Context, pod *corev1.Pod, hook *proto.HttpHook) (string, error) {
+	// hooks that skipped validation (e.g. a clone's or an export's) get the same refusal
+	if hook.Scheme != "" && hook.Scheme != "http" {
+		return "", errors.Errorf("Hook scheme %q isn't supported, only http", hook.Scheme)
+	}
+	method := hook.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(hook.Port))), hook.Path)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to build hook request")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "Hook request failed")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHookOutput))
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to read hook response")
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return string(body), errors.Errorf("Hook returned %s", resp.Status)
+	}
+	return string(body), nil
+}
+
+func truncate(out string) string {
+	if len(out) > maxHookOutput {
+		return out[:maxHookOutput]
+	}
+	return out
+}
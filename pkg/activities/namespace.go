@@ -0,0 +1,32 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NamespaceActivities struct{}
+
+// GetWindowAnnotations returns the maintenance window and timezone annotations set on the namespace (if any)
+func (a *NamespaceActivities) GetWindowAnnotations(ctx context.Context, ns string) (map[string]string, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := client.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to get namespace")
+	}
+
+	window := map[string]string{}
+	for _, key := range []string{util.MaintenanceWindowAnnotation, util.TimezoneAnnotation} {
+		if value, ok := namespace.Annotations[key]; ok {
+			window[key] = value
+		}
+	}
+	return window, nil
+}
@@ -0,0 +1,132 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// PlanActivities show what a resize would do - every write is a server-side dry run
+type PlanActivities struct{}
+
+// dryRun never persists anything but still goes through admission and validation
+var dryRun = []string{metav1.DryRunAll}
+
+// UnknownPV stands in for the name of a pv that hasn't been provisioned
+const UnknownPV = "<new pv>"
+
+// PlanStagingPVC dry-runs creating the staging pvc
+func (a *PlanActivities) PlanStagingPVC(ctx context.Context, originalPVC util.PvcInfo, size string) (*proto.Manifest, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	staging := stagingPVC(originalPVC, size)
+	pvc, err := staging.ToK8s()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert metadata to true k8s resource")
+	}
+
+	created, err := client.CoreV1().PersistentVolumeClaims(staging.Namespace).Create(ctx, pvc, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		return nil, errors.Wrap(err, "Staging pvc rejected")
+	}
+	return manifest("staging pvc", created, corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), true)
+}
+
+// PlanCopyJob dry-runs creating the job that copies the original pvc into the staging one
+func (a *PlanActivities) PlanCopyJob(ctx context.Context, originalPVC util.PvcInfo) (*proto.Manifest, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	job := makeJob(originalPVC.Namespace, syncCommand, originalPVC.Name, originalPVC.Name+stagingSuffix, nil)
+	created, err := client.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		return nil, errors.Wrap(err, "Copy job rejected")
+	}
+	return manifest("copy job", created, schema.GroupVersion{Group: "batch", Version: "v1"}.WithKind("Job"), true)
+}
+
+// PlanRebind renders the pvc that replaces the original one
+// it can't be dry-run while the original still exists so it is only rendered
+func (a *PlanActivities) PlanRebind(ctx context.Context, originalPVC util.PvcInfo, size string) (*proto.Manifest, error) {
+	rebound := reboundPVC(originalPVC, UnknownPV, size)
+	pvc, err := rebound.ToK8s()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert metadata to k8s resource")
+	}
+	return manifest("rebound pvc", pvc, corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"), false)
+}
+
+// PlanScaleDown dry-runs scaling the sts to 0 and returns its current replicas
+func (a *PlanActivities) PlanScaleDown(ctx context.Context, ns, name string) (int32, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return 0, err
+	}
+
+	stsClient := client.AppsV1().StatefulSets(ns)
+	sts, err := stsClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	replicas := int32(0)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	zero := int32(0)
+	sts.Spec.Replicas = &zero
+	_, err = stsClient.Update(ctx, sts, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		return 0, errors.Wrap(err, "Scaling StatefulSet rejected")
+	}
+	return replicas, nil
+}
+
+// PlanRetain dry-runs marking the pv retain and returns its current reclaim policy
+func (a *PlanActivities) PlanRetain(ctx context.Context, pvName string) (corev1.PersistentVolumeReclaimPolicy, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return "", err
+	}
+
+	pv, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to get pv")
+	}
+
+	policy := pv.Spec.PersistentVolumeReclaimPolicy
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	_, err = client.CoreV1().PersistentVolumes().Update(ctx, pv, metav1.UpdateOptions{DryRun: dryRun})
+	if err != nil {
+		return "", errors.Wrap(err, "Updating pv reclaim policy rejected")
+	}
+	slog.DebugContext(ctx, "Planned pv retain", "pv", pvName, "policy", policy)
+	return policy, nil
+}
+
+// manifest renders obj as yaml without the noise the api server adds
+func manifest(name string, obj runtime.Object, gvk schema.GroupVersionKind, validated bool) (*proto.Manifest, error) {
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	if meta, ok := obj.(metav1.Object); ok {
+		meta.SetManagedFields(nil)
+	}
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to render %s", name)
+	}
+	return &proto.Manifest{Name: name, Yaml: string(data), ServerValidated: validated}, nil
+}
@@ -2,11 +2,16 @@ package activities
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/aaronshifman/down-pvscope/pkg/k8s"
 	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
 	corev1 "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type PVActivities struct{}
@@ -31,3 +36,41 @@ func (pva *PVActivities) SetReclaimPolicy(ctx context.Context, pvName string, po
 	_, err = k8s.SetPVRetainPolicy(ctx, client, pvName, policy)
 	return err
 }
+
+// DeletePV puts the pv's original reclaim policy back before dropping it so a Delete policy
+// also releases the backing volume while Retain only removes the PV object
+func (pva *PVActivities) DeletePV(ctx context.Context, pvName string, policy corev1.PersistentVolumeReclaimPolicy) error {
+	slog.DebugContext(ctx, "Deleting pv", "name", pvName, "policy", policy)
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	if _, err := k8s.SetPVRetainPolicy(ctx, client, pvName, policy); k8errors.IsNotFound(err) {
+		slog.InfoContext(ctx, "PV already deleted", "pv", pvName)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return k8s.DeletePVandWait(ctx, client, pvName)
+}
+
+// CheckPVAvailable fails (without retrying) unless the pv exists and isn't being deleted
+func (pva *PVActivities) CheckPVAvailable(ctx context.Context, pvName string) error {
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	pv, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+	if k8errors.IsNotFound(err) {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("pv %s no longer exists", pvName), "PVUnavailable", err)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get pv %s", pvName)
+	}
+	if pv.DeletionTimestamp != nil {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("pv %s is being deleted", pvName), "PVUnavailable", nil)
+	}
+	return nil
+}
@@ -2,11 +2,14 @@ package activities
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/aaronshifman/down-pvscope/pkg/k8s"
 	"github.com/aaronshifman/down-pvscope/pkg/util"
 	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+	corev1 "k8s.io/api/core/v1"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -21,13 +24,62 @@ func (a *PVCActivities) CreateStagingPVC(ctx context.Context, originalPVC util.P
 		return nil, err
 	}
 
-	// clone the pvc but change name + set volume size
+	staging := stagingPVC(originalPVC, size)
+	slog.InfoContext(ctx, "Creating staging PVC", "name", staging.Name, "newSize", size)
+
+	pvc, err := staging.ToK8s()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert metadata to true k8s resource")
+	}
+
+	err = k8s.CreatePVCandWait(ctx, client, staging.Namespace, pvc)
+	if err != nil && !k8errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	// reget the new pvc so we get an updated volume name
+	newPVC, err := client.CoreV1().PersistentVolumeClaims(staging.Namespace).Get(ctx, pvc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to update the pvc reference")
+	}
+
+	return util.NewPVCInfo(newPVC), nil
+}
+
+// stagingPVC clones the pvc but changes the name and sets the volume size
+func stagingPVC(originalPVC util.PvcInfo, size string) util.PvcInfo {
 	originalPVC.VolumeName = ""
 	originalPVC.Name = originalPVC.Name + stagingSuffix
 	originalPVC.Annotations = nil
+	originalPVC.DataSourceSnapshot = ""
 	originalPVC.RequestedStorage = size
 	originalPVC.LimitStorage = size
-	slog.InfoContext(ctx, "Creating staging PVC", "name", originalPVC.Name, "newSize", size)
+	return originalPVC
+}
+
+// reboundPVC is the original pvc recreated on top of pvName at the new size
+func reboundPVC(origPVC util.PvcInfo, pvName, newSize string) util.PvcInfo {
+	origPVC.VolumeName = pvName
+	origPVC.Annotations = nil
+	origPVC.DataSourceSnapshot = ""
+	origPVC.RequestedStorage = newSize
+	origPVC.LimitStorage = newSize
+	return origPVC
+}
+
+// CreateRestorePVC provisions a copy of the original pvc at its original size
+// restored from a VolumeSnapshot, or empty when snapshot is empty
+func (a *PVCActivities) CreateRestorePVC(ctx context.Context, originalPVC util.PvcInfo, name, snapshot string) (*util.PvcInfo, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	originalPVC.VolumeName = ""
+	originalPVC.Name = name
+	originalPVC.Annotations = nil
+	originalPVC.DataSourceSnapshot = snapshot
+	slog.InfoContext(ctx, "Creating PVC from snapshot", "name", originalPVC.Name, "snapshot", snapshot)
 
 	pvc, err := originalPVC.ToK8s()
 	if err != nil {
@@ -39,7 +91,6 @@ func (a *PVCActivities) CreateStagingPVC(ctx context.Context, originalPVC util.P
 		return nil, err
 	}
 
-	// reget the new pvc so we get an updated volume name
 	newPVC, err := client.CoreV1().PersistentVolumeClaims(originalPVC.Namespace).Get(ctx, pvc.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, errors.Wrap(err, "Unable to update the pvc reference")
@@ -48,6 +99,96 @@ func (a *PVCActivities) CreateStagingPVC(ctx context.Context, originalPVC util.P
 	return util.NewPVCInfo(newPVC), nil
 }
 
+// CreateImportPVC creates an empty pvc to restore an import into
+// a claim that already exists is only accepted if this workflow created it - otherwise it belongs to someone else
+func (a *PVCActivities) CreateImportPVC(ctx context.Context, spec util.PvcInfo, owner string) (*util.PvcInfo, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	spec.VolumeName = ""
+	spec.DataSourceSnapshot = ""
+	spec.Annotations = nil
+	pvc, err := spec.ToK8s()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to convert metadata to true k8s resource")
+	}
+	pvc.Annotations = map[string]string{util.ImportedByAnnotation: owner}
+	slog.InfoContext(ctx, "Creating import PVC", "name", pvc.Name, "namespace", spec.Namespace)
+
+	err = k8s.CreatePVCandWait(ctx, client, spec.Namespace, pvc)
+	if err != nil && !k8errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	existing, err := client.CoreV1().PersistentVolumeClaims(spec.Namespace).Get(ctx, pvc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to update the pvc reference")
+	}
+	if existing.Annotations[util.ImportedByAnnotation] != owner {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("pvc %s/%s already exists - delete it or import under another name", spec.Namespace, pvc.Name),
+			"ClaimExists",
+			nil,
+		)
+	}
+
+	return util.NewPVCInfo(existing), nil
+}
+
+// CreateReplicaPVC pre-creates the claim the sts controller would create for the ordinal so it adopts it on scale up
+// the claim is restored from snapshot when set. A claim that already exists is only accepted if this
+// workflow created it - otherwise it holds some earlier replica's data
+func (a *PVCActivities) CreateReplicaPVC(ctx context.Context, ns, sts, template string, ordinal int32, snapshot, owner string) (*util.PvcInfo, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := client.AppsV1().StatefulSets(ns).Get(ctx, sts, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	pvc, err := k8s.ReplicaClaim(set, template, ordinal)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidTemplate", err)
+	}
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	pvc.Annotations[util.SeededByAnnotation] = owner
+	if snapshot != "" {
+		apiGroup := util.SnapshotAPIGroup
+		pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VolumeSnapshot",
+			Name:     snapshot,
+		}
+	}
+	slog.InfoContext(ctx, "Creating replica PVC", "name", pvc.Name, "snapshot", snapshot)
+
+	err = k8s.CreatePVCandWait(ctx, client, ns, pvc)
+	if err != nil && !k8errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	existing, err := client.CoreV1().PersistentVolumeClaims(ns).Get(ctx, pvc.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to update the pvc reference")
+	}
+	if existing.Annotations[util.SeededByAnnotation] != owner {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("pvc %s already exists - delete it before seeding ordinal %d", pvc.Name, ordinal),
+			"ClaimExists",
+			nil,
+		)
+	}
+
+	return util.NewPVCInfo(existing), nil
+}
+
 func (a *PVCActivities) DeletePVC(ctx context.Context, namespace, pvcName string) error {
 	client, err := util.GetClientset()
 	if err != nil {
@@ -63,9 +204,12 @@ func (a *PVCActivities) RebindPV(ctx context.Context, namespace, pvName string,
 		return err
 	}
 
-	slog.DebugContext(ctx, "Unlinking original PV", "pv", origPVC.VolumeName)
-	if err := k8s.UnlinkPV(ctx, client, origPVC.VolumeName); err != nil {
-		return err
+	// a pvc that never had its own volume (e.g. a clone target) has nothing to unlink
+	if origPVC.VolumeName != "" && origPVC.VolumeName != pvName {
+		slog.DebugContext(ctx, "Unlinking original PV", "pv", origPVC.VolumeName)
+		if err := k8s.UnlinkPV(ctx, client, origPVC.VolumeName); err != nil {
+			return err
+		}
 	}
 	slog.DebugContext(ctx, "Unlinking new PV", "pv", pvName)
 	if err := k8s.UnlinkPV(ctx, client, pvName); err != nil {
@@ -73,12 +217,8 @@ func (a *PVCActivities) RebindPV(ctx context.Context, namespace, pvName string,
 	}
 
 	slog.InfoContext(ctx, "Creating new PVC to match original", "name", origPVC.Name, "pv", pvName)
-	origPVC.VolumeName = pvName
-	origPVC.Annotations = nil
-	origPVC.RequestedStorage = newSize
-	origPVC.LimitStorage = newSize
-
-	pvc, err := origPVC.ToK8s()
+	rebound := reboundPVC(origPVC, pvName, newSize)
+	pvc, err := rebound.ToK8s()
 	if err != nil {
 		return errors.Wrap(err, "Unable to convert metadata to k8s resource")
 	}
@@ -2,76 +2,399 @@ package activities
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
 	"github.com/aaronshifman/down-pvscope/pkg/util"
 	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 )
 
 type JobActivities struct{}
 
-func (a *JobActivities) Runrclone(ctx context.Context, originalPVC, newPVC *util.PvcInfo, namespace string) error {
+// JobTimeout bounds how long a single rclone job may run unless the activity asks for longer
+const JobTimeout = 30 * time.Minute
+
+// JobTimeoutMargin is how much longer than the job the activity's StartToCloseTimeout has to be
+// the job's own timeout is whatever is left before the activity's deadline minus the margin
+const JobTimeoutMargin = 5 * time.Minute
+
+const (
+	srcMount  = "/data/src"
+	destMount = "/data/dest"
+	// backupRemote is configured entirely through RCLONE_CONFIG_BACKUP_* env vars
+	backupRemote = "backup"
+)
+
+var (
+	manifestDigest = regexp.MustCompile(`manifest-sha256: ([0-9a-f]{64})`)
+	sizeCount      = regexp.MustCompile(`"count":(\d+)`)
+	sizeBytes      = regexp.MustCompile(`"bytes":(\d+)`)
+	sampleRead     = regexp.MustCompile(`sample-read: (\d+) bytes in (\d+)s`)
+)
+
+// syncCommand copies the source volume onto the destination
+// json stats lines are how the job reports progress
+var syncCommand = []string{"rclone", "sync", srcMount + "/", destMount + "/", "--verbose", "--use-json-log", "--stats", "10s"}
+
+// sampleDuration bounds how long the sampling job reads to measure throughput
+const sampleDuration = 20
+
+// Runrclone syncs originalPVC into newPVC and returns rclone's final transfer stats
+func (a *JobActivities) Runrclone(ctx context.Context, originalPVC, newPVC *util.PvcInfo, namespace string) (*proto.CopyProgress, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	job := makeJob(namespace, syncCommand, originalPVC.Name, newPVC.Name, nil)
+	name, logs, err := a.runJob(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := parseProgress(logs)
+	if stats == nil {
+		// nothing needed copying before the first stats interval
+		stats = &proto.CopyProgress{}
+	}
+	stats.Job = name
+	return stats, nil
+}
+
+// SampleSource counts the bytes and files on the (possibly live) pvc and measures how fast it reads
+// the job runs next to whatever currently mounts the claim and only mounts it read-only
+func (a *JobActivities) SampleSource(ctx context.Context, pvc *util.PvcInfo) (*util.CopySample, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := k8s.ClaimNode(ctx, client, pvc.Namespace, pvc.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	script := fmt.Sprintf(`set -e
+rclone size %[1]s/ --json
+start=$(date +%%s)
+read=$(timeout %[2]d sh -c 'find %[1]s -type f -exec cat {} + 2>/dev/null' | wc -c)
+echo "sample-read: ${read} bytes in $(( $(date +%%s) - start ))s"
+`, srcMount, sampleDuration)
+
+	job := makeJob(pvc.Namespace, []string{"sh", "-c", script}, pvc.Name, "", nil)
+	job.Spec.Template.Spec.NodeName = node
+	job.Spec.Template.Spec.Containers[0].VolumeMounts[0].ReadOnly = true
+	_, logs, err := a.runJob(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+
+	count := sizeCount.FindStringSubmatch(logs)
+	size := sizeBytes.FindStringSubmatch(logs)
+	read := sampleRead.FindStringSubmatch(logs)
+	if count == nil || size == nil || read == nil {
+		return nil, errors.Errorf("Unable to parse sampling job output: %s", tail(logs))
+	}
+
+	sample := &util.CopySample{}
+	sample.Files, _ = strconv.ParseInt(count[1], 10, 64)
+	sample.Bytes, _ = strconv.ParseInt(size[1], 10, 64)
+	readBytes, _ := strconv.ParseInt(read[1], 10, 64)
+	seconds, _ := strconv.ParseInt(read[2], 10, 64)
+	// everything was read in under a second
+	seconds = max(seconds, 1)
+	sample.ReadBytesPerSecond = readBytes / seconds
+	slog.InfoContext(ctx, "Sampled source", "pvc", pvc.Name, "files", sample.Files, "bytes", sample.Bytes, "readBytesPerSecond", sample.ReadBytesPerSecond)
+	return sample, nil
+}
+
+// BackupToRemote copies the pvc to the S3-compatible remote, uploads an md5 manifest next to it and
+// returns the backup with the manifest digest filled in
+func (a *JobActivities) BackupToRemote(ctx context.Context, pvc *util.PvcInfo, backup util.BackupInfo) (*util.BackupInfo, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	remote := remotePath(backup)
+	script := fmt.Sprintf(`set -e
+rclone copy %[1]s/ %[2]s/data --verbose
+rclone md5sum %[1]s/ --output-file /tmp/MD5SUMS
+rclone copyto /tmp/MD5SUMS %[2]s/MD5SUMS
+rclone check %[1]s/ %[2]s/data --one-way
+echo "manifest-sha256: $(sha256sum /tmp/MD5SUMS | cut -d' ' -f1)"
+`, srcMount, remote)
+
+	job := makeJob(pvc.Namespace, []string{"sh", "-c", script}, pvc.Name, "", backupEnv(backup.SecretName))
+	_, logs, err := a.runJob(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+
+	match := manifestDigest.FindStringSubmatch(logs)
+	if match == nil {
+		return nil, errors.New("Backup job didn't report a manifest digest")
+	}
+	backup.Manifest = remote + "/MD5SUMS"
+	backup.ManifestSHA256 = match[1]
+	return &backup, nil
+}
+
+// RestoreFromRemote copies a backup into the pvc and checks every file against the backup's manifest
+func (a *JobActivities) RestoreFromRemote(ctx context.Context, pvc *util.PvcInfo, backup util.BackupInfo) error {
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	remote := remotePath(backup)
+	// without a known digest the manifest is trusted as is
+	checkManifest := ""
+	if backup.ManifestSHA256 != "" {
+		checkManifest = fmt.Sprintf(`echo "%s  /tmp/MD5SUMS" | sha256sum -c -`, backup.ManifestSHA256)
+	}
+	script := fmt.Sprintf(`set -e
+rclone copy %[2]s/data %[1]s/ --verbose
+rclone copyto %[2]s/MD5SUMS /tmp/MD5SUMS
+%[3]s
+rclone md5sum %[1]s/ --checkfile /tmp/MD5SUMS
+`, destMount, remote, checkManifest)
+
+	job := makeJob(pvc.Namespace, []string{"sh", "-c", script}, "", pvc.Name, backupEnv(backup.SecretName))
+	_, _, err = a.runJob(ctx, client, job)
+	return err
+}
+
+// DeleteRemote removes what BackupToRemote wrote for the backup
+// deleting a backup that is already gone succeeds
+func (a *JobActivities) DeleteRemote(ctx context.Context, ns string, backup util.BackupInfo) error {
 	client, err := util.GetClientset()
 	if err != nil {
 		return err
 	}
 
-	job := makeJob(originalPVC.Name, newPVC.Name, namespace)
-	slog.DebugContext(ctx, "New Job", "name", job.Name, "namespace", job.Namespace)
+	remote := remotePath(backup)
+	// a failed delete only counts if something is left behind
+	script := fmt.Sprintf(`rclone delete %[1]s --include "/data/**" --include "/MD5SUMS" --verbose ||
+! rclone lsf %[1]s --include "/data/**" --include "/MD5SUMS" -R | grep -q .
+`, remote)
+
+	job := makeJob(ns, []string{"sh", "-c", script}, "", "", backupEnv(backup.SecretName))
+	_, _, err = a.runJob(ctx, client, job)
+	return err
+}
+
+func remotePath(backup util.BackupInfo) string {
+	return fmt.Sprintf("%s:%s/%s", backupRemote, backup.Bucket, backup.Prefix)
+}
 
-	// Create the Job in Kubernetes
-	jobsClient := client.BatchV1().Jobs(namespace)
+// runJob creates the job, waits for it to finish and returns the name it was created under and the logs of its pod
+// the job is always cleaned up - a retried activity starts a fresh one
+func (a *JobActivities) runJob(ctx context.Context, client kubernetes.Interface, job *batchv1.Job) (string, string, error) {
+	jobsClient := client.BatchV1().Jobs(job.Namespace)
 	createdJob, err := jobsClient.Create(ctx, job, metav1.CreateOptions{})
 	if err != nil {
-		return errors.Wrap(err, "Could not create job")
+		return "", "", errors.Wrap(err, "Could not create job")
+	}
+	slog.DebugContext(ctx, "New Job", "name", createdJob.Name, "namespace", createdJob.Namespace)
+
+	// not bothering to wait because the PV/PVC will be bound to the dead pod
+	// until it's cleaned up - this is a natural rate limiting
+	// TODO: do this properly though
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		err := jobsClient.Delete(context.WithoutCancel(ctx), createdJob.Name, metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Could not delete job", "name", createdJob.Name, "error", err)
+		}
+	}()
+
+	timeout := JobTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline) - JobTimeoutMargin
 	}
-	err = wait.PollUntilContextTimeout(ctx, 5*time.Second, 30*time.Minute, true, func(ctx context.Context) (done bool, err error) {
+
+	failed := false
+	err = wait.PollUntilContextTimeout(ctx, 5*time.Second, timeout, true, func(ctx context.Context) (done bool, err error) {
 		jobStatus, err := jobsClient.Get(ctx, createdJob.Name, metav1.GetOptions{})
-		slog.DebugContext(ctx, "checking job progression", "jobName", createdJob.Name, "success", jobStatus.Status.Succeeded, "failed", jobStatus.Status.Failed)
 		if err != nil {
 			return false, err
 		}
+		slog.DebugContext(ctx, "checking job progression", "jobName", createdJob.Name, "success", jobStatus.Status.Succeeded, "failed", jobStatus.Status.Failed)
+		a.reportProgress(ctx, client, createdJob)
 
 		if jobStatus.Status.Succeeded > 0 {
 			return true, nil
 		}
 		if jobStatus.Status.Failed > 0 {
+			failed = true
 			return true, nil
 		}
 		// still running
-		// TODO: handle timeouts and kill the job
 		return false, nil
 	})
 	if err != nil {
-		return errors.Wrap(err, "Unable to complete job successfully")
+		return createdJob.Name, "", errors.Wrap(err, "Unable to complete job successfully")
 	}
 
-	// not bothering to wait because the PV/PVC will be bound to the dead pod
-	// until it's cleaned up - this is a natural rate limiting
-	// TODO: do this properly though
-	propagation := metav1.DeletePropagationBackground
-	err = jobsClient.Delete(ctx, createdJob.Name, metav1.DeleteOptions{
-		PropagationPolicy: &propagation,
+	logs, logErr := jobLogs(ctx, client, createdJob, &corev1.PodLogOptions{})
+	if failed {
+		return createdJob.Name, logs, errors.Errorf("Job %s failed: %s", createdJob.Name, tail(logs))
+	}
+	return createdJob.Name, logs, logErr
+}
+
+// reportProgress heartbeats the latest rclone stats of the job (or just its name before there are any)
+// status reads them from the pending activity rather than from the workflow's history
+func (a *JobActivities) reportProgress(ctx context.Context, client kubernetes.Interface, job *batchv1.Job) {
+	logs, err := jobLogs(ctx, client, job, &corev1.PodLogOptions{TailLines: ptr.To(int64(20))})
+	progress := parseProgress(logs)
+	if err != nil || progress == nil {
+		activity.RecordHeartbeat(ctx, job.Name)
+		return
+	}
+
+	progress.Job = job.Name
+	progress.UpdatedAt = timestamppb.Now()
+	activity.RecordHeartbeat(ctx, progress)
+}
+
+// parseProgress finds the last json stats line rclone logged
+func parseProgress(logs string) *proto.CopyProgress {
+	lines := strings.Split(strings.TrimSpace(logs), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := struct {
+			Stats *struct {
+				Bytes          int64    `json:"bytes"`
+				TotalBytes     int64    `json:"totalBytes"`
+				Transfers      int64    `json:"transfers"`
+				TotalTransfers int64    `json:"totalTransfers"`
+				ETA            *float64 `json:"eta"`
+			} `json:"stats"`
+		}{}
+		if json.Unmarshal([]byte(lines[i]), &line) != nil || line.Stats == nil {
+			continue
+		}
+
+		progress := &proto.CopyProgress{
+			Bytes:          line.Stats.Bytes,
+			TotalBytes:     line.Stats.TotalBytes,
+			Transfers:      line.Stats.Transfers,
+			TotalTransfers: line.Stats.TotalTransfers,
+		}
+		if line.Stats.ETA != nil {
+			progress.Eta = durationpb.New(time.Duration(*line.Stats.ETA * float64(time.Second)))
+		}
+		return progress
+	}
+	return nil
+}
+
+func jobLogs(ctx context.Context, client kubernetes.Interface, job *batchv1.Job, options *corev1.PodLogOptions) (string, error) {
+	pods, err := client.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "batch.kubernetes.io/job-name=" + job.Name,
 	})
 	if err != nil {
-		return errors.Wrap(err, "Could not delete job")
+		return "", errors.Wrap(err, "Unable to find job pods")
+	}
+	if len(pods.Items) == 0 {
+		return "", errors.Errorf("Job %s has no pods", job.Name)
 	}
 
-	return nil
+	// the last pod is the one that decided the job's outcome
+	pod := pods.Items[len(pods.Items)-1]
+	stream, err := client.CoreV1().Pods(job.Namespace).GetLogs(pod.Name, options).Stream(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to read job logs")
+	}
+	defer stream.Close()
+
+	logs, err := io.ReadAll(stream)
+	return string(logs), err
+}
+
+func tail(logs string) string {
+	const limit = 2048
+	if len(logs) > limit {
+		return logs[len(logs)-limit:]
+	}
+	return logs
+}
+
+// backupEnv configures the "backup" rclone remote from the keys of the referenced secret
+func backupEnv(secret string) []corev1.EnvVar {
+	fromSecret := func(name, key string, optional bool) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+					Key:                  key,
+					Optional:             &optional,
+				},
+			},
+		}
+	}
+
+	return []corev1.EnvVar{
+		{Name: "RCLONE_CONFIG_BACKUP_TYPE", Value: "s3"},
+		fromSecret("RCLONE_CONFIG_BACKUP_PROVIDER", "provider", true),
+		fromSecret("RCLONE_CONFIG_BACKUP_ACCESS_KEY_ID", "access_key_id", false),
+		fromSecret("RCLONE_CONFIG_BACKUP_SECRET_ACCESS_KEY", "secret_access_key", false),
+		fromSecret("RCLONE_CONFIG_BACKUP_ENDPOINT", "endpoint", true),
+		fromSecret("RCLONE_CONFIG_BACKUP_REGION", "region", true),
+	}
 }
 
-func makeJob(src, dst, ns string) *batchv1.Job {
+// makeJob builds an rclone job mounting src at /data/src and dst at /data/dest (either may be empty)
+func makeJob(ns string, command []string, src, dst string, env []corev1.EnvVar) *batchv1.Job {
+	backoffLimit := int32(0)
+	mounts := []corev1.VolumeMount{}
+	volumes := []corev1.Volume{}
+	for _, v := range []struct{ name, claim, path string }{{"source", src, srcMount}, {"dest", dst, destMount}} {
+		if v.claim == "" {
+			continue
+		}
+		mounts = append(mounts, corev1.VolumeMount{
+			Name:      v.name,
+			MountPath: v.path,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: v.name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: v.claim,
+				},
+			},
+		})
+	}
+
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "rclone-sync-job",
-			Namespace: ns,
+			GenerateName: "rclone-",
+			Namespace:    ns,
 		},
 		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
 			Template: corev1.PodTemplateSpec{
 				Spec: corev1.PodSpec{
 					// TODO: this is eh-eh-ron hackery for kyverno rewrites
@@ -79,41 +402,14 @@ func makeJob(src, dst, ns string) *batchv1.Job {
 					RestartPolicy:    corev1.RestartPolicyNever,
 					Containers: []corev1.Container{
 						{
-							Name:  "rclone",
-							Image: "rclone/rclone:latest",
-							Command: []string{
-								"rclone", "sync", "/data/src/", "/data/dest/", "--verbose",
-							},
-							VolumeMounts: []corev1.VolumeMount{
-								{
-									Name:      "source",
-									MountPath: "/data/src",
-								},
-								{
-									Name:      "dest",
-									MountPath: "/data/dest",
-								},
-							},
-						},
-					},
-					Volumes: []corev1.Volume{
-						{
-							Name: "source",
-							VolumeSource: corev1.VolumeSource{
-								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-									ClaimName: src,
-								},
-							},
-						},
-						{
-							Name: "dest",
-							VolumeSource: corev1.VolumeSource{
-								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-									ClaimName: dst,
-								},
-							},
+							Name:         "rclone",
+							Image:        "rclone/rclone:latest",
+							Command:      command,
+							Env:          env,
+							VolumeMounts: mounts,
 						},
 					},
+					Volumes: volumes,
 				},
 			},
 		},
@@ -0,0 +1,100 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ResultActivities struct{}
+
+// PersistResult keeps the result of a resize in its namespace so it outlives the workflow's history
+// name identifies the resize and names the ConfigMap
+func (a *ResultActivities) PersistResult(ctx context.Context, sink proto.ResultSink, name string, result *proto.ScaleResult) error {
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	data, err := protojson.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "Unable to encode result")
+	}
+
+	switch sink {
+	case proto.ResultSink_RESULT_SINK_ANNOTATION:
+		pvcs := client.CoreV1().PersistentVolumeClaims(result.Namespace)
+		pvc, err := pvcs.Get(ctx, result.Pvc, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrap(err, "Unable to get pvc")
+		}
+		if pvc.Annotations == nil {
+			pvc.Annotations = map[string]string{}
+		}
+		pvc.Annotations[util.LastResizeAnnotation] = string(data)
+		_, err = pvcs.Update(ctx, pvc, metav1.UpdateOptions{})
+		if err != nil {
+			return errors.Wrap(err, "Unable to annotate pvc")
+		}
+
+	case proto.ResultSink_RESULT_SINK_CONFIGMAP:
+		configMaps := client.CoreV1().ConfigMaps(result.Namespace)
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{util.ResizedPVCLabel: result.Pvc},
+			},
+			Data: map[string]string{util.ResultKey: string(data)},
+		}
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		if k8errors.IsAlreadyExists(err) {
+			// a retried attempt
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return errors.Wrap(err, "Unable to write result ConfigMap")
+		}
+
+	default:
+		return errors.Errorf("Unknown result sink %s", sink)
+	}
+
+	slog.InfoContext(ctx, "Persisted resize result", "pvc", result.Pvc, "sink", sink)
+	return nil
+}
+
+// PublishCampaignReport replaces the report kept in the campaign's ConfigMap
+func (a *ResultActivities) PublishCampaignReport(ctx context.Context, ns, name string, report *proto.CampaignReport) error {
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	data, err := protojson.Marshal(report)
+	if err != nil {
+		return errors.Wrap(err, "Unable to encode report")
+	}
+
+	configMaps := client.CoreV1().ConfigMaps(ns)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Data:       map[string]string{util.CampaignReportKey: string(data)},
+	}
+	_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	if k8errors.IsAlreadyExists(err) {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.Wrap(err, "Unable to write report ConfigMap")
+	}
+
+	slog.InfoContext(ctx, "Published campaign report", "namespace", ns, "configmap", name)
+	return nil
+}
@@ -0,0 +1,168 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type ScanActivities struct{}
+
+// ScanNamespaces resolves the namespaces a scan covers
+func (a *ScanActivities) ScanNamespaces(ctx context.Context, scan *proto.Scan) ([]string, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := slices.Clone(scan.Namespaces)
+	if scan.NamespaceSelector != "" {
+		list, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: scan.NamespaceSelector})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to list namespaces")
+		}
+		for _, ns := range list.Items {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+	slices.Sort(namespaces)
+	return slices.Compact(namespaces), nil
+}
+
+// Scan measures every bound pvc in the namespaces and recommends the ones worth shrinking
+// usage comes from the kubelets of the nodes the pvcs are mounted on so unmounted pvcs can't be measured
+func (a *ScanActivities) Scan(ctx context.Context, scan *proto.Scan, namespaces []string) (*proto.ScanReport, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+	options, err := util.ScanLimits(scan)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := []util.PvcInfo{}
+	owners := map[string]string{}
+	nodes := map[string]bool{}
+	histories := map[string][]util.UsageSample{}
+	for _, ns := range namespaces {
+		activity.RecordHeartbeat(ctx, ns)
+		nsClaims, err := scanNamespace(ctx, client, ns, owners, nodes)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, nsClaims...)
+
+		history, err := usageHistory(ctx, client, ns)
+		if err != nil {
+			return nil, err
+		}
+		for pvc, samples := range history {
+			histories[ns+"/"+pvc] = samples
+		}
+	}
+
+	usage, err := volumeUsage(ctx, client, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &proto.ScanReport{Scanned: int32(len(claims))}
+	for _, claim := range claims {
+		key := claim.Namespace + "/" + claim.Name
+		stats, ok := usage[key]
+		if !ok {
+			report.Unmeasured = append(report.Unmeasured, key)
+			continue
+		}
+		recommendation, err := util.Recommend(claim, owners[key], stats, histories[key], options)
+		if err != nil {
+			return nil, err
+		}
+		if recommendation != nil {
+			report.Recommendations = append(report.Recommendations, recommendation)
+		}
+	}
+	util.RankRecommendations(report.Recommendations)
+
+	slog.InfoContext(ctx, "Scanned pvcs", "namespaces", len(namespaces), "claims", len(claims), "recommendations", len(report.Recommendations))
+	return report, nil
+}
+
+// scanNamespace lists the bound claims of a namespace, noting the statefulsets owning them and the nodes mounting them
+func scanNamespace(ctx context.Context, client kubernetes.Interface, ns string, owners map[string]string, nodes map[string]bool) ([]util.PvcInfo, error) {
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to list pvcs in %s", ns)
+	}
+	stss, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to list statefulsets in %s", ns)
+	}
+	if err := mountingNodes(ctx, client, ns, nodes); err != nil {
+		return nil, err
+	}
+
+	claims := []util.PvcInfo{}
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		if pvc.Status.Phase != corev1.ClaimBound {
+			continue
+		}
+		claims = append(claims, *util.NewPVCInfo(pvc))
+		if owner := k8s.TemplateOwners(stss.Items, pvc.Name); len(owner) == 1 {
+			owners[ns+"/"+pvc.Name] = owner[0]
+		}
+	}
+	return claims, nil
+}
+
+// mountingNodes adds the nodes running a pod of the namespace that mounts a pvc
+func mountingNodes(ctx context.Context, client kubernetes.Interface, ns string, nodes map[string]bool) error {
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "Unable to list pods in %s", ns)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				nodes[pod.Spec.NodeName] = true
+			}
+		}
+	}
+	return nil
+}
+
+// volumeUsage reads the volume stats of the nodes' kubelets keyed by <namespace>/<pvc>
+// a kubelet that can't be reached is skipped so the claims it mounts are left unmeasured rather than failing everything
+func volumeUsage(ctx context.Context, client kubernetes.Interface, nodes map[string]bool) (map[string]util.VolumeStats, error) {
+	usage := map[string]util.VolumeStats{}
+	for node := range nodes {
+		activity.RecordHeartbeat(ctx, node)
+		stats, err := k8s.NodeVolumeStats(ctx, client, node)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			slog.WarnContext(ctx, "Unable to read kubelet volume stats - leaving its claims unmeasured", "node", node, "error", err)
+			continue
+		}
+		for _, s := range stats {
+			usage[s.Namespace+"/"+s.PVC] = s
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,44 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+)
+
+type SnapshotActivities struct{}
+
+// SnapshotReadyTimeout bounds how long a snapshot may take to become readyToUse
+const SnapshotReadyTimeout = 30 * time.Minute
+
+// SnapshotDeleteTimeout bounds how long a snapshot may take to go away once deleted
+const SnapshotDeleteTimeout = 5 * time.Minute
+
+func (a *SnapshotActivities) CreateSnapshot(ctx context.Context, ns, name, pvc, class string) error {
+	slog.InfoContext(ctx, "Snapshotting pvc", "pvc", pvc, "snapshot", name, "namespace", ns)
+	client, err := util.GetDynamicClient()
+	if err != nil {
+		return err
+	}
+
+	err = k8s.CreateSnapshotAndWait(ctx, client, ns, name, pvc, class, SnapshotReadyTimeout)
+	if errors.Is(err, k8s.ErrForeignSnapshot) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "SnapshotExists", err)
+	}
+	return err
+}
+
+func (a *SnapshotActivities) DeleteSnapshot(ctx context.Context, ns, name string) error {
+	slog.InfoContext(ctx, "Deleting snapshot", "snapshot", name, "namespace", ns)
+	client, err := util.GetDynamicClient()
+	if err != nil {
+		return err
+	}
+
+	return k8s.DeleteSnapshot(ctx, client, ns, name, SnapshotDeleteTimeout)
+}
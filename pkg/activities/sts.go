@@ -3,9 +3,14 @@ package activities
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/aaronshifman/down-pvscope/pkg/k8s"
 	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type STSActivities struct{}
@@ -39,3 +44,123 @@ func (a *STSActivities) ScaleUp(ctx context.Context, ns, sts string, replicas in
 
 	return k8s.ScaleSTS(ctx, client, ns, sts, replicas)
 }
+
+// SetReplicas only sets the replicas - unlike ScaleUp it leaves deciding whether the pods come up to WaitReady
+func (a *STSActivities) SetReplicas(ctx context.Context, ns, sts string, replicas int32) error {
+	slog.DebugContext(ctx, "Setting sts replicas", "name", sts, "namespace", ns, "replicas", replicas)
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	return k8s.SetReplicas(ctx, client, ns, sts, replicas)
+}
+
+// ReadyTimeout bounds how long the replicas of a scaled up sts may take to become ready
+const ReadyTimeout = 10 * time.Minute
+
+// WaitReady waits for every replica of the sts to be ready
+// a crashlooping container fails it straight away (without retrying) since it won't get better by waiting
+func (a *STSActivities) WaitReady(ctx context.Context, ns, sts string, replicas int32) error {
+	slog.DebugContext(ctx, "Waiting for sts to be ready", "name", sts, "namespace", ns, "replicas", replicas)
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		ready, err := k8s.STSReady(ctx, client, ns, sts, replicas)
+		if err != nil {
+			return err
+		}
+		if ready {
+			return nil
+		}
+
+		pods, err := k8s.GetSTSPods(ctx, client, ns, sts)
+		if err != nil {
+			return err
+		}
+		if problem := k8s.CrashLoopProblem(pods); problem != "" {
+			return temporal.NewNonRetryableApplicationError(problem, "VerificationFailed", nil)
+		}
+		activity.RecordHeartbeat(ctx, len(pods))
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "sts %s never became ready", sts)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *STSActivities) CheckDisruptionBudgets(ctx context.Context, ns, sts string) ([]string, error) {
+	slog.DebugContext(ctx, "Checking disruption budgets for", "name", sts, "namespace", ns)
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	return k8s.DisruptionBudgetViolations(ctx, client, ns, sts)
+}
+
+// WaitStable fails (without retrying) if any pod of the sts restarts, crashloops or goes unready before stableFor passes
+func (a *STSActivities) WaitStable(ctx context.Context, ns, sts string, replicas int32, stableFor time.Duration) error {
+	slog.DebugContext(ctx, "Waiting for sts to be stable", "name", sts, "namespace", ns, "duration", stableFor)
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	pods, err := k8s.GetSTSPods(ctx, client, ns, sts)
+	if err != nil {
+		return err
+	}
+	baseline := k8s.RestartCounts(pods)
+
+	deadline := time.Now().Add(stableFor)
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		problem, err := k8s.STSHealthProblem(ctx, client, ns, sts, replicas, baseline)
+		if err != nil {
+			return err
+		}
+		if problem != "" {
+			return temporal.NewNonRetryableApplicationError(problem, "VerificationFailed", nil)
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+		activity.RecordHeartbeat(ctx, remaining.String())
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetClaimTemplates lists the names of the sts's volumeClaimTemplates
+func (a *STSActivities) GetClaimTemplates(ctx context.Context, ns, sts string) ([]string, error) {
+	client, err := util.GetClientset()
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := client.AppsV1().StatefulSets(ns).Get(ctx, sts, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	templates := []string{}
+	for _, t := range set.Spec.VolumeClaimTemplates {
+		templates = append(templates, t.Name)
+	}
+	return templates, nil
+}
@@ -0,0 +1,120 @@
+package activities
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	corev1 "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type UsageActivities struct{}
+
+// CollectUsage adds a sample of every mounted pvc's used bytes to the usage history of its namespace
+func (a *UsageActivities) CollectUsage(ctx context.Context, namespaces []string, retention time.Duration) error {
+	client, err := util.GetClientset()
+	if err != nil {
+		return err
+	}
+
+	nodes := map[string]bool{}
+	for _, ns := range namespaces {
+		activity.RecordHeartbeat(ctx, ns)
+		if err := mountingNodes(ctx, client, ns, nodes); err != nil {
+			return err
+		}
+	}
+	usage, err := volumeUsage(ctx, client, nodes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, ns := range namespaces {
+		activity.RecordHeartbeat(ctx, ns)
+		samples := map[string]util.UsageSample{}
+		for _, stats := range usage {
+			if stats.Namespace == ns {
+				samples[stats.PVC] = util.UsageSample{At: now, UsedBytes: stats.UsedBytes}
+			}
+		}
+		if err := recordUsage(ctx, client, ns, samples, now, retention); err != nil {
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "Collected usage", "namespaces", len(namespaces), "pvcs", len(usage))
+	return nil
+}
+
+// usageHistory reads the usage history of every pvc in the namespace, it's empty before the first collection
+func usageHistory(ctx context.Context, client kubernetes.Interface, ns string) (map[string][]util.UsageSample, error) {
+	cm, err := client.CoreV1().ConfigMaps(ns).Get(ctx, util.UsageHistoryConfigMap, metav1.GetOptions{})
+	if k8errors.IsNotFound(err) {
+		return map[string][]util.UsageSample{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get usage history of %s", ns)
+	}
+
+	histories, unreadable := util.DecodeUsageHistories(cm.Data)
+	for pvc, err := range unreadable {
+		// the pvc is scanned as if it had no history, the next collection starts it over
+		slog.WarnContext(ctx, "Ignoring unreadable usage history", "namespace", ns, "pvc", pvc, "error", err)
+	}
+	return histories, nil
+}
+
+// recordUsage appends the samples to the namespace's usage history and compacts it
+// histories of pvcs that are no longer sampled are kept until their samples age out
+func recordUsage(ctx context.Context, client kubernetes.Interface, ns string, samples map[string]util.UsageSample, now time.Time, retention time.Duration) error {
+	configMaps := client.CoreV1().ConfigMaps(ns)
+	cm, err := configMaps.Get(ctx, util.UsageHistoryConfigMap, metav1.GetOptions{})
+	exists := err == nil
+	if k8errors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: util.UsageHistoryConfigMap, Namespace: ns}}
+	} else if err != nil {
+		return errors.Wrapf(err, "Unable to get usage history of %s", ns)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+
+	for pvc, value := range cm.Data {
+		history, err := util.DecodeUsageHistory(value)
+		if err != nil {
+			// a damaged history is worth less than the collection, start it over
+			slog.WarnContext(ctx, "Dropping unreadable usage history", "namespace", ns, "pvc", pvc, "error", err)
+			history = nil
+		}
+		if sample, ok := samples[pvc]; ok {
+			history = append(history, sample)
+			delete(samples, pvc)
+		}
+		history = util.CompactUsageHistory(history, now, retention)
+		if len(history) == 0 {
+			delete(cm.Data, pvc)
+			continue
+		}
+		cm.Data[pvc] = util.EncodeUsageHistory(history)
+	}
+	for pvc, sample := range samples {
+		cm.Data[pvc] = util.EncodeUsageHistory([]util.UsageSample{sample})
+	}
+
+	if exists {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	} else {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Unable to write usage history of %s", ns)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// WorkflowActivities reach back into temporal to inspect or nudge other workflows
+type WorkflowActivities struct {
+	Client client.Client
+}
+
+func (a *WorkflowActivities) GetResizeRecord(ctx context.Context, workflowID string) (*util.ResizeRecord, error) {
+	slog.DebugContext(ctx, "Querying resize record", "workflowID", workflowID)
+	value, err := a.Client.QueryWorkflow(ctx, workflowID, "", util.RecordQuery)
+	var queryFailed *serviceerror.QueryFailed
+	if errors.As(err, &queryFailed) {
+		// asking again won't make a workflow without a record grow one
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("workflow %s keeps no resize record: %s", workflowID, queryFailed.Message), "NotRollbackable", err)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to query resize workflow")
+	}
+
+	record := &util.ResizeRecord{}
+	if err := value.Get(record); err != nil {
+		return nil, errors.Wrap(err, "Unable to decode resize record")
+	}
+	return record, nil
+}
+
+// KeepOriginalPV stops a resize workflow that is still waiting out its retention period from deleting the original pv
+// it returns the resize's record once the resize has acted on the signal (or finished) so the caller knows whether
+// the pv was kept or its deletion had already started
+func (a *WorkflowActivities) KeepOriginalPV(ctx context.Context, workflowID string) (*util.ResizeRecord, error) {
+	err := a.Client.SignalWorkflow(ctx, workflowID, "", util.OriginalPVSignal, util.OriginalPVKeep)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		// the workflow already finished - nothing is going to delete the pv
+		slog.DebugContext(ctx, "Resize workflow no longer running", "workflowID", workflowID)
+		return a.GetResizeRecord(ctx, workflowID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to signal resize workflow")
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		record, err := a.GetResizeRecord(ctx, workflowID)
+		if err != nil {
+			return nil, err
+		}
+		if record.OriginalPVKept || record.OriginalPVDeleting {
+			return record, nil
+		}
+
+		description, err := a.Client.DescribeWorkflowExecution(ctx, workflowID, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to describe resize workflow")
+		}
+		if description.WorkflowExecutionInfo.Status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			// it may have finished between the two calls so only its final record counts
+			return a.GetResizeRecord(ctx, workflowID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "Resize workflow never acted on the keep signal")
+		case <-ticker.C:
+		}
+	}
+}
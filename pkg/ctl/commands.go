@@ -0,0 +1,709 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v3"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Flags are the temporal connection flags every command needs
+func Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     "temporal-url",
+			Sources:  cli.EnvVars("TEMPORAL_ADDRESS"),
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "temporal-namespace",
+			Sources:  cli.EnvVars("TEMPORAL_NAMESPACE"),
+			Required: true,
+		},
+	}
+}
+
+// Dial connects to temporal with the connection flags
+func Dial(cmd *cli.Command) (client.Client, error) {
+	c, err := client.Dial(client.Options{
+		HostPort:  cmd.String("temporal-url"),
+		Namespace: cmd.String("temporal-namespace"),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to create Temporal client")
+	}
+	return c, nil
+}
+
+// Commands are the commands that drive resizes
+func Commands() []*cli.Command {
+	workflowID := &cli.StringFlag{
+		Name:     "workflow-id",
+		Required: true,
+	}
+	scheduleID := &cli.StringFlag{
+		Name:     "id",
+		Required: true,
+	}
+	return []*cli.Command{
+		{
+			Name:  "scale",
+			Usage: "start resizing a pvc of a statefulset",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "namespace",
+					Aliases:  []string{"n"},
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pvc",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "size",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "sts",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "allow-disruption",
+					Usage: "continue when a pdb doesn't allow the statefulset to be scaled down",
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "only plan the resize",
+				},
+				&cli.BoolFlag{
+					Name:  "watch",
+					Usage: "render the progress until the resize finishes",
+				},
+			},
+			Action: withClient(scale),
+		},
+		{
+			Name:   "status",
+			Usage:  "show the progress of a resize",
+			Flags:  []cli.Flag{workflowID},
+			Action: withClient(status),
+		},
+		{
+			Name:  "watch",
+			Usage: "render the progress of a resize until it finishes",
+			Flags: []cli.Flag{
+				workflowID,
+				&cli.DurationFlag{
+					Name:  "interval",
+					Value: 5 * time.Second,
+				},
+			},
+			Action: withClient(watch),
+		},
+		{
+			Name:  "list",
+			Usage: "list running resizes and the ones that recently finished",
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  "since",
+					Usage: "also list resizes that finished within this long, 0 lists only running ones",
+					Value: 24 * time.Hour,
+				},
+			},
+			Action: withClient(list),
+		},
+		{
+			Name:   "cancel",
+			Usage:  "cancel a resize",
+			Flags:  []cli.Flag{workflowID},
+			Action: withClient(cancel),
+		},
+		{
+			Name:  "fleet",
+			Usage: "resize many pvcs at once",
+			Commands: []*cli.Command{
+				{
+					Name:  "start",
+					Usage: "start a FleetResizeWorkflow from a yaml or json file of targets",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:     "file",
+							Aliases:  []string{"f"},
+							Required: true,
+						},
+						&cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "defaults to pvscope-fleet-<start time>",
+						},
+						&cli.Int32Flag{
+							Name:  "max-concurrency",
+							Usage: "overrides the file's max_concurrency",
+						},
+						&cli.Int32Flag{
+							Name:  "max-per-namespace",
+							Usage: "overrides the file's max_per_namespace",
+						},
+						&cli.Int32Flag{
+							Name:  "max-failures",
+							Usage: "overrides the file's max_failures",
+						},
+						&cli.BoolFlag{
+							Name:  "wait",
+							Usage: "wait for the fleet to finish and print its report",
+						},
+					},
+					Action: withClient(fleetStart),
+				},
+				{
+					Name:   "report",
+					Usage:  "show the report of a fleet so far",
+					Flags:  []cli.Flag{workflowID},
+					Action: withClient(fleetReport),
+				},
+			},
+		},
+		{
+			Name:  "scan",
+			Usage: "recommend pvcs worth shrinking from the usage their kubelets report",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:    "namespace",
+					Aliases: []string{"n"},
+				},
+				&cli.StringFlag{
+					Name:  "namespace-selector",
+					Usage: "also scan the namespaces matching this label selector",
+				},
+				&cli.Float64Flag{
+					Name:  "headroom",
+					Usage: "free space kept on top of the used bytes as a fraction of them",
+					Value: util.DefaultHeadroom,
+				},
+				&cli.StringFlag{
+					Name:  "min-reclaim",
+					Usage: "don't recommend smaller savings",
+					Value: "1Gi",
+				},
+				&cli.DurationFlag{
+					Name:  "growth-horizon",
+					Usage: "leave room for the growth the usage history projects over this long",
+					Value: util.DefaultGrowthHorizon,
+				},
+				&cli.StringFlag{
+					Name:    "output",
+					Aliases: []string{"o"},
+					Usage:   "table, json or fleet - fleet prints a file for fleet start",
+					Value:   "table",
+				},
+			},
+			Action: withClient(scan),
+		},
+		{
+			Name:  "campaign",
+			Usage: "scan for pvcs to shrink on a Temporal schedule",
+			Commands: []*cli.Command{
+				{
+					Name:  "create",
+					Usage: "create a schedule starting a CampaignWorkflow",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:     "id",
+							Required: true,
+						},
+						&cli.StringFlag{
+							Name:  "cron",
+							Usage: "when the campaign runs",
+							Value: "0 3 * * SUN",
+						},
+						&cli.StringFlag{
+							Name:  "timezone",
+							Value: "UTC",
+						},
+						&cli.StringSliceFlag{
+							Name:    "namespace",
+							Aliases: []string{"n"},
+						},
+						&cli.StringFlag{
+							Name:  "namespace-selector",
+							Usage: "defaults to " + util.AutoLabel + "=true without --namespace",
+						},
+						&cli.Float64Flag{
+							Name:  "max-usage",
+							Usage: "only act on pvcs using less than this fraction of their size",
+						},
+						&cli.Float64Flag{
+							Name:  "headroom",
+							Value: util.DefaultHeadroom,
+						},
+						&cli.StringFlag{
+							Name:  "min-reclaim",
+							Value: "1Gi",
+						},
+						&cli.DurationFlag{
+							Name:  "growth-horizon",
+							Value: util.DefaultGrowthHorizon,
+						},
+						&cli.StringFlag{
+							Name:  "action",
+							Usage: "report, or resize to start an approval-gated resize for every selected pvc",
+							Value: "report",
+						},
+						&cli.DurationFlag{
+							Name:  "approval-timeout",
+							Usage: "how long each resize waits for its approval, forever when 0",
+						},
+						&cli.StringFlag{
+							Name: "report-namespace",
+						},
+						&cli.StringFlag{
+							Name:  "report-configmap",
+							Usage: "keep the latest report in this ConfigMap",
+						},
+						&cli.StringFlag{
+							Name:  "overlap",
+							Usage: "skip, buffer-one, buffer-all, cancel-other, terminate-other or allow-all",
+							Value: "skip",
+						},
+						&cli.DurationFlag{
+							Name:  "catchup-window",
+							Usage: "how late a missed run may still start",
+							Value: time.Hour,
+						},
+						&cli.BoolFlag{
+							Name: "pause-on-failure",
+						},
+						&cli.BoolFlag{
+							Name: "paused",
+						},
+						&cli.StringFlag{
+							Name: "note",
+						},
+					},
+					Action: withClient(campaignCreate),
+				},
+				{
+					Name:   "list",
+					Usage:  "list campaign schedules",
+					Action: withClient(campaignList),
+				},
+				{
+					Name:  "pause",
+					Usage: "pause a campaign schedule",
+					Flags: []cli.Flag{
+						scheduleID,
+						&cli.StringFlag{Name: "note"},
+					},
+					Action: withClient(campaignPause),
+				},
+				{
+					Name:  "unpause",
+					Usage: "unpause a campaign schedule",
+					Flags: []cli.Flag{
+						scheduleID,
+						&cli.StringFlag{Name: "note"},
+					},
+					Action: withClient(campaignUnpause),
+				},
+				{
+					Name:   "trigger",
+					Usage:  "run a campaign now",
+					Flags:  []cli.Flag{scheduleID},
+					Action: withClient(campaignTrigger),
+				},
+				{
+					Name:   "delete",
+					Usage:  "delete a campaign schedule, resizes it started keep running",
+					Flags:  []cli.Flag{scheduleID},
+					Action: withClient(campaignDelete),
+				},
+			},
+		},
+		{
+			Name:  "usage",
+			Usage: "collect the pvc usage history scans project growth from",
+			Commands: []*cli.Command{
+				{
+					Name:  "schedule",
+					Usage: "create a schedule starting a UsageCollectorWorkflow",
+					Flags: append(usageFlags(),
+						&cli.StringFlag{
+							Name:  "id",
+							Value: "pvscope-usage",
+						},
+						&cli.DurationFlag{
+							Name:  "every",
+							Usage: "how often usage is sampled",
+							Value: 6 * time.Hour,
+						},
+					),
+					Action: withClient(usageSchedule),
+				},
+				{
+					Name:   "collect",
+					Usage:  "sample usage once now",
+					Flags:  usageFlags(),
+					Action: withClient(usageCollect),
+				},
+				{
+					Name:  "delete",
+					Usage: "stop collecting usage on a schedule, the history collected so far is kept",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "id",
+							Usage: "the usage schedule to delete",
+							Value: "pvscope-usage",
+						},
+					},
+					Action: withClient(usageDelete),
+				},
+			},
+		},
+		{
+			Name:  "approve",
+			Usage: "approve (or --reject) the plan of a resize waiting for approval",
+			Flags: []cli.Flag{
+				workflowID,
+				&cli.StringFlag{
+					Name:    "approver",
+					Sources: cli.EnvVars("USER"),
+				},
+				&cli.StringFlag{
+					Name: "reason",
+				},
+				&cli.BoolFlag{
+					Name: "reject",
+				},
+			},
+			Action: withClient(approve),
+		},
+	}
+}
+
+func usageFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:    "namespace",
+			Aliases: []string{"n"},
+		},
+		&cli.StringFlag{
+			Name:  "namespace-selector",
+			Usage: "defaults to " + util.AutoLabel + "=true without --namespace",
+		},
+		&cli.DurationFlag{
+			Name:  "retention",
+			Usage: "how long samples are kept",
+			Value: util.DefaultUsageRetention,
+		},
+	}
+}
+
+type clientAction func(ctx context.Context, cmd *cli.Command, c client.Client) error
+
+func withClient(action clientAction) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		c, err := Dial(cmd)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		return action(ctx, cmd, c)
+	}
+}
+
+func scale(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	req := &protov2.ScaleRequest{
+		Workload: &protov2.WorkloadRef{
+			Namespace:   cmd.String("namespace"),
+			Statefulset: cmd.String("sts"),
+		},
+		Target: &protov2.Target{
+			Pvc:  cmd.String("pvc"),
+			Size: cmd.String("size"),
+		},
+		Options: &protov2.Options{
+			Safety: &protov2.SafetyOptions{AllowDisruption: cmd.Bool("allow-disruption")},
+		},
+		DryRun: cmd.Bool("dry-run"),
+	}
+	run, err := Start(ctx, c, req)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Started %s (run %s)\n", run.GetID(), run.GetRunID())
+
+	if !cmd.Bool("watch") && !req.DryRun {
+		return nil
+	}
+	result, err := Watch(ctx, c, run.GetID(), 5*time.Second, cmd.Writer)
+	if err != nil {
+		return err
+	}
+	return printResult(cmd, result)
+}
+
+func status(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	s, err := Status(ctx, c, cmd.String("workflow-id"))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(cmd.Writer, FormatStatus(s))
+	return nil
+}
+
+func watch(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	result, err := Watch(ctx, c, cmd.String("workflow-id"), cmd.Duration("interval"), cmd.Writer)
+	if err != nil {
+		return err
+	}
+	return printResult(cmd, result)
+}
+
+func list(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	summaries, err := List(ctx, c, cmd.Duration("since"))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(cmd.Writer, FormatList(summaries))
+	return nil
+}
+
+func cancel(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	if err := Cancel(ctx, c, cmd.String("workflow-id")); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Requested cancellation of %s\n", cmd.String("workflow-id"))
+	return nil
+}
+
+func fleetStart(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	data, err := os.ReadFile(cmd.String("file"))
+	if err != nil {
+		return errors.Wrap(err, "Unable to read fleet")
+	}
+	fleet, err := util.ParseFleet(data)
+	if err != nil {
+		return err
+	}
+	if cmd.IsSet("max-concurrency") {
+		fleet.MaxConcurrency = cmd.Int32("max-concurrency")
+	}
+	if cmd.IsSet("max-per-namespace") {
+		fleet.MaxPerNamespace = cmd.Int32("max-per-namespace")
+	}
+	if cmd.IsSet("max-failures") {
+		fleet.MaxFailures = cmd.Int32("max-failures")
+	}
+
+	id := cmd.String("workflow-id")
+	if id == "" {
+		id = "pvscope-fleet-" + time.Now().UTC().Format("20060102-150405")
+	}
+	run, err := StartFleet(ctx, c, id, fleet)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Started %s with %d targets\n", run.GetID(), len(fleet.Targets))
+	if !cmd.Bool("wait") {
+		return nil
+	}
+
+	report := &proto.FleetReport{}
+	if err := run.Get(ctx, &report); err != nil {
+		return errors.Wrap(err, "Fleet failed")
+	}
+	FillFleetResults(ctx, c, report)
+	_, _ = fmt.Fprint(cmd.Writer, FormatFleetReport(report))
+	return nil
+}
+
+func fleetReport(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	report, err := FleetReport(ctx, c, cmd.String("workflow-id"))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(cmd.Writer, FormatFleetReport(report))
+	return nil
+}
+
+func scan(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	s := &proto.Scan{
+		Namespaces:        cmd.StringSlice("namespace"),
+		NamespaceSelector: cmd.String("namespace-selector"),
+		Headroom:          cmd.Float64("headroom"),
+		MinReclaim:        cmd.String("min-reclaim"),
+		GrowthHorizon:     durationpb.New(cmd.Duration("growth-horizon")),
+	}
+	if len(s.Namespaces) == 0 && s.NamespaceSelector == "" {
+		return errors.New("scan needs a --namespace or a --namespace-selector")
+	}
+
+	report, err := Scan(ctx, c, s)
+	if err != nil {
+		return err
+	}
+	switch cmd.String("output") {
+	case "table":
+		_, _ = fmt.Fprint(cmd.Writer, FormatScanReport(report))
+	case "json":
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(report)
+		if err != nil {
+			return errors.Wrap(err, "Unable to render report")
+		}
+		_, _ = fmt.Fprintln(cmd.Writer, string(out))
+	case "fleet":
+		out, err := FleetManifest(report)
+		if err != nil {
+			return err
+		}
+		_, _ = cmd.Writer.Write(out)
+	default:
+		return errors.Errorf("unknown output %q", cmd.String("output"))
+	}
+	return nil
+}
+
+func campaignCreate(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	campaign := &proto.Campaign{
+		Scan: &proto.Scan{
+			Namespaces:        cmd.StringSlice("namespace"),
+			NamespaceSelector: cmd.String("namespace-selector"),
+			Headroom:          cmd.Float64("headroom"),
+			MinReclaim:        cmd.String("min-reclaim"),
+			GrowthHorizon:     durationpb.New(cmd.Duration("growth-horizon")),
+		},
+		MaxUsage:        cmd.Float64("max-usage"),
+		ReportNamespace: cmd.String("report-namespace"),
+		ReportConfigmap: cmd.String("report-configmap"),
+	}
+	if len(campaign.Scan.Namespaces) == 0 && campaign.Scan.NamespaceSelector == "" {
+		campaign.Scan.NamespaceSelector = util.AutoLabel + "=true"
+	}
+	switch cmd.String("action") {
+	case "report":
+		campaign.Action = proto.CampaignAction_CAMPAIGN_ACTION_REPORT
+	case "resize":
+		campaign.Action = proto.CampaignAction_CAMPAIGN_ACTION_RESIZE
+	default:
+		return errors.Errorf("unknown action %q", cmd.String("action"))
+	}
+	if timeout := cmd.Duration("approval-timeout"); timeout > 0 {
+		campaign.ApprovalTimeout = durationpb.New(timeout)
+	}
+
+	overlap, err := ParseOverlapPolicy(cmd.String("overlap"))
+	if err != nil {
+		return err
+	}
+	handle, err := ScheduleCampaign(ctx, c, CampaignSchedule{
+		ID:             cmd.String("id"),
+		Cron:           cmd.String("cron"),
+		Timezone:       cmd.String("timezone"),
+		Overlap:        overlap,
+		CatchupWindow:  cmd.Duration("catchup-window"),
+		PauseOnFailure: cmd.Bool("pause-on-failure"),
+		Paused:         cmd.Bool("paused"),
+		Note:           cmd.String("note"),
+	}, campaign)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Created schedule %s\n", handle.GetID())
+	return nil
+}
+
+func campaignList(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	entries, err := ListCampaigns(ctx, c)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprint(cmd.Writer, FormatCampaigns(entries))
+	return nil
+}
+
+func campaignPause(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	handle := c.ScheduleClient().GetHandle(ctx, cmd.String("id"))
+	return errors.Wrap(handle.Pause(ctx, client.SchedulePauseOptions{Note: cmd.String("note")}), "Unable to pause schedule")
+}
+
+func campaignUnpause(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	handle := c.ScheduleClient().GetHandle(ctx, cmd.String("id"))
+	return errors.Wrap(handle.Unpause(ctx, client.ScheduleUnpauseOptions{Note: cmd.String("note")}), "Unable to unpause schedule")
+}
+
+func campaignTrigger(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	handle := c.ScheduleClient().GetHandle(ctx, cmd.String("id"))
+	return errors.Wrap(handle.Trigger(ctx, client.ScheduleTriggerOptions{}), "Unable to trigger schedule")
+}
+
+func campaignDelete(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	handle := c.ScheduleClient().GetHandle(ctx, cmd.String("id"))
+	return errors.Wrap(handle.Delete(ctx), "Unable to delete schedule")
+}
+
+func usageCollection(cmd *cli.Command) *proto.UsageCollection {
+	collection := &proto.UsageCollection{
+		Namespaces:        cmd.StringSlice("namespace"),
+		NamespaceSelector: cmd.String("namespace-selector"),
+		Retention:         durationpb.New(cmd.Duration("retention")),
+	}
+	if len(collection.Namespaces) == 0 && collection.NamespaceSelector == "" {
+		collection.NamespaceSelector = util.AutoLabel + "=true"
+	}
+	return collection
+}
+
+func usageSchedule(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	handle, err := ScheduleUsageCollection(ctx, c, cmd.String("id"), cmd.Duration("every"), usageCollection(cmd))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Created schedule %s\n", handle.GetID())
+	return nil
+}
+
+func usageCollect(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	if err := CollectUsage(ctx, c, usageCollection(cmd)); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(cmd.Writer, "Collected usage")
+	return nil
+}
+
+func usageDelete(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	if err := DeleteUsageCollection(ctx, c, cmd.String("id")); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Deleted usage schedule %s\n", cmd.String("id"))
+	return nil
+}
+
+func approve(ctx context.Context, cmd *cli.Command, c client.Client) error {
+	decision := &proto.ApprovalDecision{
+		Approved: !cmd.Bool("reject"),
+		Approver: cmd.String("approver"),
+		Reason:   cmd.String("reason"),
+	}
+	if err := Approve(ctx, c, cmd.String("workflow-id"), decision); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.Writer, "Sent decision approved=%t approver=%s\n", decision.Approved, decision.Approver)
+	return nil
+}
+
+func printResult(cmd *cli.Command, result *proto.ScaleResult) error {
+	out, err := protojson.MarshalOptions{Multiline: true}.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "Unable to render result")
+	}
+	_, _ = fmt.Fprintln(cmd.Writer, string(out))
+	return nil
+}
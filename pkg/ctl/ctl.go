@@ -0,0 +1,244 @@
+// Package ctl drives resize workflows from outside the worker - it backs the cli and the kubectl plugin
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// WorkflowID is the id the cli and fleets give the resize of a pvc
+func WorkflowID(ns, pvc string) string {
+	return util.ResizeWorkflowID(ns, pvc)
+}
+
+// Start validates the request and starts a ScaleWorkflow for it
+func Start(ctx context.Context, c client.Client, req *protov2.ScaleRequest) (client.WorkflowRun, error) {
+	if err := util.ValidateScaleRequest(req); err != nil {
+		return nil, err
+	}
+
+	id := WorkflowID(req.Workload.Namespace, req.Target.Pvc)
+	if req.DryRun {
+		// a plan never conflicts with a real resize of the same pvc
+		id += "/plan"
+	}
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       id,
+		TaskQueue:                workflows.TaskQueueName,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+		WorkflowIDReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}, workflows.ScaleWorkflow, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to start resize %s", id)
+	}
+	return run, nil
+}
+
+// Status queries the current ScaleStatus of a resize
+func Status(ctx context.Context, c client.Client, workflowID string) (*proto.ScaleStatus, error) {
+	value, err := c.QueryWorkflow(ctx, workflowID, "", util.StatusQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to query resize status")
+	}
+
+	status := &proto.ScaleStatus{}
+	if err := value.Get(&status); err != nil {
+		return nil, errors.Wrap(err, "Unable to decode resize status")
+	}
+
+	// copy jobs only heartbeat their progress - it never goes through the workflow's history
+	description, err := c.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to describe resize")
+	}
+	status.CopyProgress = CopyProgress(description.PendingActivities)
+	return status, nil
+}
+
+// CopyProgress picks the latest progress a running copy job heartbeated out of the pending activities
+func CopyProgress(pending []*workflowpb.PendingActivityInfo) *proto.CopyProgress {
+	var latest *proto.CopyProgress
+	for _, activity := range pending {
+		if activity.HeartbeatDetails == nil {
+			continue
+		}
+		// before rclone logs any stats the job only heartbeats its name
+		progress := &proto.CopyProgress{}
+		if converter.GetDefaultDataConverter().FromPayloads(activity.HeartbeatDetails, &progress) != nil || progress.Job == "" {
+			continue
+		}
+		if latest == nil || progress.UpdatedAt.AsTime().After(latest.UpdatedAt.AsTime()) {
+			latest = progress
+		}
+	}
+	return latest
+}
+
+// Watch renders the status every interval until the resize finishes and returns its result
+func Watch(ctx context.Context, c client.Client, workflowID string, interval time.Duration, out io.Writer) (*proto.ScaleResult, error) {
+	run := c.GetWorkflow(ctx, workflowID, "")
+	done := make(chan error, 1)
+	result := &proto.ScaleResult{}
+	go func() {
+		done <- run.Get(ctx, &result)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := Status(ctx, c, workflowID)
+		if err == nil {
+			_, _ = fmt.Fprintln(out, FormatStatus(status))
+		}
+
+		select {
+		case err := <-done:
+			if err != nil {
+				return nil, errors.Wrap(err, "Resize failed")
+			}
+			return result, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// FormatStatus renders a status for a terminal
+func FormatStatus(status *proto.ScaleStatus) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	row := func(key, format string, args ...any) {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", key, fmt.Sprintf(format, args...))
+	}
+
+	step := status.Step
+	if status.ParkedAt != "" {
+		step += " (parked at " + status.ParkedAt + ")"
+	}
+	row("step", "%s since %s", step, status.StepStartedAt.AsTime().Format(time.RFC3339))
+	row("pvc", "%s on %s", status.OriginalPvc, status.OriginalPv)
+	if status.StagingPvc != "" {
+		row("staging", "%s on %s", status.StagingPvc, status.StagingPv)
+	}
+	row("replicas", "%d", status.InitialReplicas)
+	if p := status.CopyProgress; p != nil {
+		copied := fmt.Sprintf("%d/%d bytes, %d/%d files", p.Bytes, p.TotalBytes, p.Transfers, p.TotalTransfers)
+		if p.Eta != nil {
+			copied += ", eta " + p.Eta.AsDuration().String()
+		}
+		row("copy", "%s", copied)
+	}
+	if a := status.PendingApproval; a != nil {
+		expires := "never expires"
+		if a.ExpiresAt != nil {
+			expires = "expires " + a.ExpiresAt.AsTime().Format(time.RFC3339)
+		}
+		row("approval", "pending since %s, %s", a.RequestedAt.AsTime().Format(time.RFC3339), expires)
+	}
+	for _, s := range status.CompletedSteps {
+		row("  "+s.Name, "%s", s.FinishedAt.AsTime().Sub(s.StartedAt.AsTime()))
+	}
+	_ = w.Flush()
+	return b.String()
+}
+
+// Summary is a line of List's output
+type Summary struct {
+	WorkflowID string
+	Type       string
+	Status     string
+	Started    time.Time
+	Closed     time.Time
+}
+
+// List finds running resizes, or also the ones that finished in the last `since` when it's set
+func List(ctx context.Context, c client.Client, since time.Duration) ([]Summary, error) {
+	query := "WorkflowType IN ('ScaleWorkflow', 'ScaleDownWorkflow') AND "
+	if since > 0 {
+		query += fmt.Sprintf("(ExecutionStatus = 'Running' OR CloseTime > '%s')", time.Now().Add(-since).UTC().Format(time.RFC3339))
+	} else {
+		query += "ExecutionStatus = 'Running'"
+	}
+
+	summaries := []Summary{}
+	var token []byte
+	for {
+		resp, err := c.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Query:         query,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to list resizes")
+		}
+		for _, info := range resp.Executions {
+			summary := Summary{
+				WorkflowID: info.Execution.WorkflowId,
+				Type:       info.Type.Name,
+				Status:     strings.TrimPrefix(info.Status.String(), "WORKFLOW_EXECUTION_STATUS_"),
+				Started:    info.StartTime.AsTime(),
+			}
+			if info.CloseTime != nil {
+				summary.Closed = info.CloseTime.AsTime()
+			}
+			summaries = append(summaries, summary)
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			return summaries, nil
+		}
+	}
+}
+
+// FormatList renders summaries as a table
+func FormatList(summaries []Summary) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKFLOW ID\tTYPE\tSTATUS\tSTARTED\tCLOSED")
+	for _, s := range summaries {
+		closed := "-"
+		if !s.Closed.IsZero() {
+			closed = s.Closed.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.WorkflowID, s.Type, s.Status, s.Started.Format(time.RFC3339), closed)
+	}
+	_ = w.Flush()
+	return b.String()
+}
+
+// Cancel asks a resize to stop - it cleans up after itself where it still can
+func Cancel(ctx context.Context, c client.Client, workflowID string) error {
+	return errors.Wrap(c.CancelWorkflow(ctx, workflowID, ""), "Unable to cancel resize")
+}
+
+// Approve sends a decision as a workflow update so a refused decision is reported back
+func Approve(ctx context.Context, c client.Client, workflowID string, decision *proto.ApprovalDecision) error {
+	handle, err := c.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   workflowID,
+		UpdateName:   util.ApprovalUpdate,
+		Args:         []interface{}{decision},
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Unable to send approval")
+	}
+	if err := handle.Get(ctx, nil); err != nil {
+		return errors.Wrap(err, "Approval was refused")
+	}
+	return nil
+}
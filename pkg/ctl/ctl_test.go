@@ -0,0 +1,186 @@
+package ctl_test
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/ctl"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/converter"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWorkflowID(t *testing.T) {
+	require.Equal(t, "pvscope/db/data-db-0", ctl.WorkflowID("db", "data-db-0"))
+	// a namespace that is a prefix of another doesn't share its prefix
+	require.NotContains(t, ctl.WorkflowID("db-prod", "data"), ctl.WorkflowID("db", ""))
+}
+
+func TestFormatStatus(t *testing.T) {
+	start := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Status   *proto.ScaleStatus
+		Contains []string
+		Missing  []string
+	}{
+		{
+			Name:     "started",
+			Status:   &proto.ScaleStatus{Step: "preflight", StepStartedAt: timestamppb.New(start), OriginalPvc: "data-db-0", OriginalPv: "pv-1"},
+			Contains: []string{"preflight since 2025-01-01T01:00:00Z", "data-db-0 on pv-1"},
+			Missing:  []string{"staging:", "copy:", "approval:"},
+		},
+		{
+			Name: "copying",
+			Status: &proto.ScaleStatus{
+				Step:          "copy",
+				StepStartedAt: timestamppb.New(start),
+				StagingPvc:    "data-db-0-staging",
+				CopyProgress:  &proto.CopyProgress{Bytes: 10, TotalBytes: 20, Transfers: 1, TotalTransfers: 2, Eta: durationpb.New(time.Minute)},
+				CompletedSteps: []*proto.StepTiming{
+					{Name: "scale-down", StartedAt: timestamppb.New(start), FinishedAt: timestamppb.New(start.Add(30 * time.Second))},
+				},
+			},
+			Contains: []string{"staging:", "10/20 bytes, 1/2 files, eta 1m0s", "scale-down:", "30s"},
+		},
+		{
+			Name:     "parked",
+			Status:   &proto.ScaleStatus{Step: "rebind", StepStartedAt: timestamppb.New(start), ParkedAt: "before-rebind"},
+			Contains: []string{"rebind (parked at before-rebind)"},
+		},
+		{
+			Name:     "approval",
+			Status:   &proto.ScaleStatus{Step: "approval", StepStartedAt: timestamppb.New(start), PendingApproval: &proto.PendingApproval{RequestedAt: timestamppb.New(start)}},
+			Contains: []string{"pending since 2025-01-01T01:00:00Z, never expires"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			out := ctl.FormatStatus(tt.Status)
+			for _, s := range tt.Contains {
+				require.Contains(t, out, s)
+			}
+			for _, s := range tt.Missing {
+				require.NotContains(t, out, s)
+			}
+		})
+	}
+}
+
+func TestCopyProgress(t *testing.T) {
+	start := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	heartbeat := func(details any) *workflowpb.PendingActivityInfo {
+		payloads, err := converter.GetDefaultDataConverter().ToPayloads(details)
+		require.NoError(t, err)
+		return &workflowpb.PendingActivityInfo{HeartbeatDetails: payloads}
+	}
+	older := &proto.CopyProgress{Job: "rclone-abc", Bytes: 10, UpdatedAt: timestamppb.New(start)}
+	newer := &proto.CopyProgress{Job: "rclone-def", Bytes: 20, UpdatedAt: timestamppb.New(start.Add(time.Minute))}
+
+	testCases := []struct {
+		Name     string
+		Pending  []*workflowpb.PendingActivityInfo
+		Progress *proto.CopyProgress
+	}{
+		{Name: "none"},
+		{Name: "noheartbeat", Pending: []*workflowpb.PendingActivityInfo{{}}},
+		{Name: "jobname", Pending: []*workflowpb.PendingActivityInfo{heartbeat("rclone-abc")}},
+		{Name: "stats", Pending: []*workflowpb.PendingActivityInfo{heartbeat(older)}, Progress: older},
+		{Name: "latest", Pending: []*workflowpb.PendingActivityInfo{heartbeat(newer), heartbeat(older)}, Progress: newer},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			progress := ctl.CopyProgress(tt.Pending)
+			if tt.Progress == nil {
+				require.Nil(t, progress)
+				return
+			}
+			require.True(t, protobuf.Equal(tt.Progress, progress))
+		})
+	}
+}
+
+func TestFormatList(t *testing.T) {
+	start := time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)
+	out := ctl.FormatList([]ctl.Summary{
+		{WorkflowID: "pvscope/db/data-db-0", Type: "ScaleWorkflow", Status: "RUNNING", Started: start},
+		{WorkflowID: "pvscope/db/data-db-1", Type: "ScaleWorkflow", Status: "COMPLETED", Started: start, Closed: start.Add(time.Hour)},
+	})
+
+	require.Contains(t, out, "WORKFLOW ID")
+	require.Regexp(t, `pvscope/db/data-db-0\s+ScaleWorkflow\s+RUNNING\s+2025-01-01T01:00:00Z\s+-`, out)
+	require.Regexp(t, `pvscope/db/data-db-1\s+ScaleWorkflow\s+COMPLETED\s+2025-01-01T01:00:00Z\s+2025-01-01T02:00:00Z`, out)
+}
+
+func TestParseClaimRef(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Ref   string
+		Claim string
+		Error bool
+	}{
+		{Name: "short", Ref: "pvc/data-db-0", Claim: "data-db-0"},
+		{Name: "long", Ref: "persistentvolumeclaim/data-db-0", Claim: "data-db-0"},
+		{Name: "bare", Ref: "data-db-0", Claim: "data-db-0"},
+		{Name: "pod", Ref: "pod/db-0", Error: true},
+		{Name: "noname", Ref: "pvc/", Error: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			claim, err := ctl.ParseClaimRef(tt.Ref)
+			if tt.Error {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.Claim, claim)
+		})
+	}
+}
+
+func TestFormatFleetReport(t *testing.T) {
+	out := ctl.FormatFleetReport(&proto.FleetReport{
+		Outcomes: []*proto.FleetOutcome{
+			{Namespace: "foo", Pvc: "data-db-0", Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED, Result: &proto.ScaleResult{OldSize: "20Gi", NewSize: "10Gi", Downtime: durationpb.New(time.Minute)}},
+			{Namespace: "bar", Pvc: "data-db-0", Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_FAILED, Error: "boom"},
+		},
+		Succeeded: 1,
+		Failed:    1,
+		Remaining: 2,
+	})
+
+	require.Regexp(t, `foo\s+data-db-0\s+SUCCEEDED\s+20Gi -> 10Gi\s+1m0s`, out)
+	require.Regexp(t, `bar\s+data-db-0\s+FAILED\s+-\s+-\s+boom`, out)
+	require.Contains(t, out, "1 succeeded, 1 failed, 0 skipped, 2 remaining")
+}
+
+func TestFleetManifest(t *testing.T) {
+	out, err := ctl.FleetManifest(&proto.ScanReport{Recommendations: []*proto.Recommendation{
+		{Namespace: "foo", Pvc: "data-db-0", Sts: "db", SuggestedSize: "13Gi"},
+	}})
+	require.NoError(t, err)
+
+	fleet, err := util.ParseFleet(out)
+	require.NoError(t, err)
+	require.Len(t, fleet.Targets, 1)
+	require.Equal(t, "13Gi", fleet.Targets[0].Size)
+}
+
+func TestParseOverlapPolicy(t *testing.T) {
+	policy, err := ctl.ParseOverlapPolicy("buffer-one")
+	require.NoError(t, err)
+	require.Equal(t, enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, policy)
+
+	_, err = ctl.ParseOverlapPolicy("sometimes")
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// StartFleet validates the fleet and starts a FleetResizeWorkflow for it
+func StartFleet(ctx context.Context, c client.Client, id string, fleet *proto.Fleet) (client.WorkflowRun, error) {
+	if err := util.ValidateFleet(fleet); err != nil {
+		return nil, err
+	}
+
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                       id,
+		TaskQueue:                workflows.TaskQueueName,
+		WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+	}, workflows.FleetResizeWorkflow, fleet)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to start fleet %s", id)
+	}
+	return run, nil
+}
+
+// FleetReport queries the report of a fleet so far, or its final report once it's done
+func FleetReport(ctx context.Context, c client.Client, id string) (*proto.FleetReport, error) {
+	value, err := c.QueryWorkflow(ctx, id, "", util.FleetQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to query fleet")
+	}
+
+	report := &proto.FleetReport{}
+	if err := value.Get(&report); err != nil {
+		return nil, errors.Wrap(err, "Unable to decode fleet report")
+	}
+	FillFleetResults(ctx, c, report)
+	return report, nil
+}
+
+// FillFleetResults reads the results and errors of the children of earlier fleet runs back from the children
+// a child whose history is gone keeps just its status
+func FillFleetResults(ctx context.Context, c client.Client, report *proto.FleetReport) {
+	for _, o := range report.Outcomes {
+		if o.RunId == "" || o.Result != nil || o.Error != "" || o.Status == proto.FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED {
+			continue
+		}
+		result := &proto.ScaleResult{}
+		err := c.GetWorkflow(ctx, o.WorkflowId, o.RunId).Get(ctx, &result)
+		var notFound *serviceerror.NotFound
+		switch {
+		case errors.As(err, &notFound):
+		case err != nil:
+			o.Error = err.Error()
+		default:
+			o.Result = result
+		}
+	}
+}
+
+// FormatFleetReport renders a report as a table of targets followed by the totals
+func FormatFleetReport(report *proto.FleetReport) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tPVC\tSTATUS\tSIZE\tDOWNTIME\tERROR")
+	for _, o := range report.Outcomes {
+		size, downtime := "-", "-"
+		if o.Result != nil {
+			size = o.Result.OldSize + " -> " + o.Result.NewSize
+			downtime = o.Result.GetDowntime().AsDuration().String()
+		}
+		status := strings.TrimPrefix(o.Status.String(), "FLEET_TARGET_STATUS_")
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", o.Namespace, o.Pvc, status, size, downtime, o.Error)
+	}
+	_ = w.Flush()
+	_, _ = fmt.Fprintf(b, "\n%d succeeded, %d failed, %d skipped, %d remaining\n", report.Succeeded, report.Failed, report.Skipped, report.Remaining)
+	return b.String()
+}
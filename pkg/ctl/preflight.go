@@ -0,0 +1,145 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// KubeFlags locate the cluster the same way kubectl does
+type KubeFlags struct {
+	Kubeconfig string
+	Context    string
+	Namespace  string
+}
+
+// Connect builds a client from the kubeconfig and resolves the namespace from the flags or the context
+func (f KubeFlags) Connect() (kubernetes.Interface, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = f.Kubeconfig
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{
+		CurrentContext: f.Context,
+		Context:        clientcmdapi.Context{Namespace: f.Namespace},
+	})
+
+	ns, _, err := config.Namespace()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Unable to resolve namespace")
+	}
+	cfg, err := config.ClientConfig()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Unable to load kubeconfig")
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "Unable to create kubernetes client")
+	}
+	return client, ns, nil
+}
+
+// ParseClaimRef accepts a claim the way kubectl names it: pvc/name, persistentvolumeclaim/name or just name
+func ParseClaimRef(ref string) (string, error) {
+	kind, name, found := strings.Cut(ref, "/")
+	if !found {
+		kind, name = "pvc", ref
+	}
+	switch kind {
+	case "pvc", "pvcs", "persistentvolumeclaim", "persistentvolumeclaims":
+	default:
+		return "", errors.Errorf("%s is not a pvc", ref)
+	}
+	if name == "" {
+		return "", errors.Errorf("%s has no name", ref)
+	}
+	return name, nil
+}
+
+// Preflight is what a resize is about to touch, gathered before it is submitted
+type Preflight struct {
+	Namespace    string
+	PVC          string
+	PV           string
+	StorageClass string
+	CurrentSize  string
+	NewSize      string
+	STS          string
+	Replicas     int32
+	Violations   []string
+	// Problems stop the resize from being submitted
+	Problems []string
+}
+
+// RunPreflight reads the claim and its statefulset (found from the claim when sts is empty)
+func RunPreflight(ctx context.Context, client kubernetes.Interface, ns, pvc, sts, size string) (*Preflight, error) {
+	claim, err := client.CoreV1().PersistentVolumeClaims(ns).Get(ctx, pvc, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get pvc %s/%s", ns, pvc)
+	}
+	info := util.NewPVCInfo(claim)
+
+	if sts == "" {
+		sts, err = k8s.ClaimOwner(ctx, client, ns, pvc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	replicas, err := k8s.GetReplicas(ctx, client, ns, sts)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := k8s.DisruptionBudgetViolations(ctx, client, ns, sts)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Preflight{
+		Namespace:    ns,
+		PVC:          pvc,
+		PV:           info.VolumeName,
+		StorageClass: info.StorageClassName,
+		CurrentSize:  info.RequestedStorage,
+		NewSize:      size,
+		STS:          sts,
+		Replicas:     replicas,
+		Violations:   violations,
+		Problems:     []string{},
+	}
+	if claim.Status.Phase != corev1.ClaimBound {
+		p.Problems = append(p.Problems, fmt.Sprintf("pvc is %s, not Bound", claim.Status.Phase))
+	}
+	if err := util.CheckShrink(info.RequestedStorage, size); err != nil {
+		p.Problems = append(p.Problems, err.Error())
+	}
+	return p, nil
+}
+
+// FormatPreflight renders a preflight for a terminal
+func FormatPreflight(p *Preflight) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	row := func(key, format string, args ...any) {
+		_, _ = fmt.Fprintf(w, "%s:\t%s\n", key, fmt.Sprintf(format, args...))
+	}
+
+	row("pvc", "%s/%s on %s (%s)", p.Namespace, p.PVC, p.PV, p.StorageClass)
+	row("size", "%s -> %s", p.CurrentSize, p.NewSize)
+	row("statefulset", "%s, scaled from %d to 0 during the copy", p.STS, p.Replicas)
+	for _, v := range p.Violations {
+		row("disruption", "%s", v)
+	}
+	for _, problem := range p.Problems {
+		row("problem", "%s", problem)
+	}
+	_ = w.Flush()
+	return b.String()
+}
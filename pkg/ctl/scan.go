@@ -0,0 +1,83 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/yaml"
+)
+
+// Scan runs a ScanWorkflow and waits for its report
+func Scan(ctx context.Context, c client.Client, scan *proto.Scan) (*proto.ScanReport, error) {
+	if _, err := util.ScanLimits(scan); err != nil {
+		return nil, err
+	}
+
+	id := "pvscope-scan-" + time.Now().UTC().Format("20060102-150405")
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        id,
+		TaskQueue: workflows.TaskQueueName,
+	}, workflows.ScanWorkflow, scan)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to start scan %s", id)
+	}
+
+	report := &proto.ScanReport{}
+	if err := run.Get(ctx, &report); err != nil {
+		return nil, errors.Wrap(err, "Scan failed")
+	}
+	return report, nil
+}
+
+// FormatScanReport renders the recommendations as a table, largest savings first
+func FormatScanReport(report *proto.ScanReport) string {
+	gib := func(bytes int64) string {
+		return fmt.Sprintf("%.1f", float64(bytes)/(1<<30))
+	}
+
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tPVC\tSTS\tSIZE\tUSED (GiB)\tPROJECTED (GiB)\tSUGGESTED\tRECLAIMABLE (GiB)")
+	for _, r := range report.Recommendations {
+		sts := r.Sts
+		if sts == "" {
+			sts = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Namespace, r.Pvc, sts, r.CurrentSize, gib(r.UsedBytes), gib(r.ProjectedBytes), r.SuggestedSize, gib(r.ReclaimableBytes))
+	}
+	_ = w.Flush()
+
+	total := int64(0)
+	for _, r := range report.Recommendations {
+		total += r.ReclaimableBytes
+	}
+	_, _ = fmt.Fprintf(b, "\n%d pvcs scanned, %d worth shrinking, %s reclaimable", report.Scanned, len(report.Recommendations), resource.NewQuantity(total, resource.BinarySI))
+	if len(report.Unmeasured) > 0 {
+		_, _ = fmt.Fprintf(b, ", not measured (not mounted or kubelet unreachable): %s", strings.Join(report.Unmeasured, ", "))
+	}
+	_, _ = fmt.Fprintln(b)
+	return b.String()
+}
+
+// FleetManifest renders the recommendations as a fleet file for `fleet start`
+func FleetManifest(report *proto.ScanReport) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(util.FleetFromScan(report))
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to encode fleet")
+	}
+	data, err = yaml.JSONToYAML(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to encode fleet")
+	}
+	return data, nil
+}
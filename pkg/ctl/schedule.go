@@ -0,0 +1,121 @@
+package ctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+var overlapPolicies = map[string]enumspb.ScheduleOverlapPolicy{
+	"skip":            enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+	"buffer-one":      enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+	"buffer-all":      enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+	"cancel-other":    enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER,
+	"terminate-other": enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER,
+	"allow-all":       enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+}
+
+// ParseOverlapPolicy maps the cli's names for what a schedule does when a run is still going
+func ParseOverlapPolicy(name string) (enumspb.ScheduleOverlapPolicy, error) {
+	policy, ok := overlapPolicies[name]
+	if !ok {
+		return enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, errors.Errorf("unknown overlap policy %q", name)
+	}
+	return policy, nil
+}
+
+// CampaignSchedule is when and how a campaign runs
+type CampaignSchedule struct {
+	ID             string
+	Cron           string
+	Timezone       string
+	Overlap        enumspb.ScheduleOverlapPolicy
+	CatchupWindow  time.Duration
+	PauseOnFailure bool
+	Paused         bool
+	Note           string
+}
+
+// ScheduleCampaign creates a Temporal schedule starting a CampaignWorkflow
+func ScheduleCampaign(ctx context.Context, c client.Client, schedule CampaignSchedule, campaign *proto.Campaign) (client.ScheduleHandle, error) {
+	if err := util.ValidateCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	handle, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID: schedule.ID,
+		Spec: client.ScheduleSpec{
+			CronExpressions: []string{schedule.Cron},
+			TimeZoneName:    schedule.Timezone,
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        schedule.ID,
+			Workflow:  workflows.CampaignWorkflow,
+			Args:      []interface{}{campaign},
+			TaskQueue: workflows.TaskQueueName,
+		},
+		Overlap:        schedule.Overlap,
+		CatchupWindow:  schedule.CatchupWindow,
+		PauseOnFailure: schedule.PauseOnFailure,
+		Paused:         schedule.Paused,
+		Note:           schedule.Note,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create schedule %s", schedule.ID)
+	}
+	return handle, nil
+}
+
+// ListCampaigns lists the schedules that start campaigns
+func ListCampaigns(ctx context.Context, c client.Client) ([]*client.ScheduleListEntry, error) {
+	iter, err := c.ScheduleClient().List(ctx, client.ScheduleListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to list schedules")
+	}
+
+	entries := []*client.ScheduleListEntry{}
+	for iter.HasNext() {
+		entry, err := iter.Next()
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to list schedules")
+		}
+		if entry.WorkflowType.Name == "CampaignWorkflow" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// FormatCampaigns renders campaign schedules as a table
+func FormatCampaigns(entries []*client.ScheduleListEntry) string {
+	b := &strings.Builder{}
+	w := tabwriter.NewWriter(b, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SCHEDULE ID\tSPEC\tPAUSED\tLAST RUN\tNEXT RUN\tNOTE")
+	for _, e := range entries {
+		spec := "-"
+		if e.Spec != nil && len(e.Spec.CronExpressions) > 0 {
+			spec = strings.Join(e.Spec.CronExpressions, ", ")
+		} else if e.Spec != nil && len(e.Spec.Calendars) > 0 {
+			spec = fmt.Sprintf("%d calendars", len(e.Spec.Calendars))
+		}
+		last, next := "-", "-"
+		if n := len(e.RecentActions); n > 0 {
+			last = e.RecentActions[n-1].ActualTime.Format(time.RFC3339)
+		}
+		if len(e.NextActionTimes) > 0 {
+			next = e.NextActionTimes[0].Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\n", e.ID, spec, e.Paused, last, next, e.Note)
+	}
+	_ = w.Flush()
+	return b.String()
+}
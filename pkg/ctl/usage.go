@@ -0,0 +1,65 @@
+package ctl
+
+import (
+	"context"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// ScheduleUsageCollection creates a Temporal schedule sampling pvc usage every interval
+func ScheduleUsageCollection(ctx context.Context, c client.Client, id string, every time.Duration, collection *proto.UsageCollection) (client.ScheduleHandle, error) {
+	if err := util.ValidateUsageCollection(collection); err != nil {
+		return nil, err
+	}
+	if every <= 0 {
+		return nil, errors.New("the collection interval must be positive")
+	}
+
+	handle, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID: id,
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{{Every: every}},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        id,
+			Workflow:  workflows.UsageCollectorWorkflow,
+			Args:      []interface{}{collection},
+			TaskQueue: workflows.TaskQueueName,
+		},
+		// a missed sample is no loss, the next one is never far
+		Overlap: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create schedule %s", id)
+	}
+	return handle, nil
+}
+
+// DeleteUsageCollection stops a usage collection schedule, the usage history it collected stays in place
+func DeleteUsageCollection(ctx context.Context, c client.Client, id string) error {
+	handle := c.ScheduleClient().GetHandle(ctx, id)
+	return errors.Wrapf(handle.Delete(ctx), "Unable to delete usage schedule %s", id)
+}
+
+// CollectUsage runs a UsageCollectorWorkflow once and waits for it
+func CollectUsage(ctx context.Context, c client.Client, collection *proto.UsageCollection) error {
+	if err := util.ValidateUsageCollection(collection); err != nil {
+		return err
+	}
+
+	id := "pvscope-usage-" + time.Now().UTC().Format("20060102-150405")
+	run, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        id,
+		TaskQueue: workflows.TaskQueueName,
+	}, workflows.UsageCollectorWorkflow, collection)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to start usage collection %s", id)
+	}
+	return errors.Wrap(run.Get(ctx, nil), "Usage collection failed")
+}
@@ -0,0 +1,43 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecInPod runs command in the pod and returns whatever it wrote to stdout/stderr
+// a non-zero exit code is returned as an error alongside the output
+func ExecInPod(ctx context.Context, client kubernetes.Interface, cfg *rest.Config, ns, pod, container string, command []string) (string, string, error) {
+	slog.DebugContext(ctx, "Executing in pod", "pod", pod, "container", container, "command", command)
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return "", "", errors.Wrap(err, "Unable to create pod executor")
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	return stdout.String(), stderr.String(), err
+}
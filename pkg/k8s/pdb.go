@@ -0,0 +1,91 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DisruptionBudgetViolations lists every PDB covering the statefulset's pods that would be
+// violated by scaling it to zero replicas.
+// Scaling a statefulset doesn't go through the eviction API so k8s won't enforce these for us
+func DisruptionBudgetViolations(ctx context.Context, client kubernetes.Interface, ns, name string) ([]string, error) {
+	sts, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	replicas := int32(0)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	pdbs, err := client.PolicyV1().PodDisruptionBudgets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list PodDisruptionBudgets")
+	}
+
+	podLabels := labels.Set(sts.Spec.Template.Labels)
+	violations := []string{}
+	for i := range pdbs.Items {
+		pdb := &pdbs.Items[i]
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid selector on PodDisruptionBudget %s", pdb.Name)
+		}
+
+		// an empty selector matches every pod in the namespace in policy/v1
+		if !selector.Matches(podLabels) {
+			continue
+		}
+
+		slog.DebugContext(ctx, "Found matching PDB", "pdb", pdb.Name, "sts", name)
+		reason, err := ScaleToZeroViolation(pdb, replicas)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			violations = append(violations, reason)
+		}
+	}
+
+	return violations, nil
+}
+
+// ScaleToZeroViolation explains why taking all `replicas` pods covered by the pdb down at once
+// would violate it. An empty string means the pdb tolerates it
+func ScaleToZeroViolation(pdb *policyv1.PodDisruptionBudget, replicas int32) (string, error) {
+	// nothing running means nothing to disrupt
+	if replicas == 0 {
+		return "", nil
+	}
+
+	if pdb.Spec.MinAvailable != nil {
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, int(replicas), true)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid minAvailable on PodDisruptionBudget %s", pdb.Name)
+		}
+		if minAvailable > 0 {
+			return fmt.Sprintf("PodDisruptionBudget %s requires minAvailable=%s of %d replicas", pdb.Name, pdb.Spec.MinAvailable.String(), replicas), nil
+		}
+	}
+
+	if pdb.Spec.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, int(replicas), true)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid maxUnavailable on PodDisruptionBudget %s", pdb.Name)
+		}
+		if maxUnavailable < int(replicas) {
+			return fmt.Sprintf("PodDisruptionBudget %s allows maxUnavailable=%s of %d replicas", pdb.Name, pdb.Spec.MaxUnavailable.String(), replicas), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,81 @@
+package k8s_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestScaleToZeroViolation(t *testing.T) {
+	minAvailable := func(v intstr.IntOrString) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{Spec: policyv1.PodDisruptionBudgetSpec{MinAvailable: &v}}
+	}
+	maxUnavailable := func(v intstr.IntOrString) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{Spec: policyv1.PodDisruptionBudgetSpec{MaxUnavailable: &v}}
+	}
+
+	testCases := []struct {
+		Name     string
+		PDB      *policyv1.PodDisruptionBudget
+		Replicas int32
+		Violated bool
+	}{
+		{Name: "minavailable", PDB: minAvailable(intstr.FromInt32(1)), Replicas: 3, Violated: true},
+		{Name: "minavailablezero", PDB: minAvailable(intstr.FromInt32(0)), Replicas: 3, Violated: false},
+		{Name: "minavailablepercent", PDB: minAvailable(intstr.FromString("10%")), Replicas: 3, Violated: true},
+		{Name: "maxunavailable", PDB: maxUnavailable(intstr.FromInt32(1)), Replicas: 3, Violated: true},
+		{Name: "maxunavailableall", PDB: maxUnavailable(intstr.FromInt32(3)), Replicas: 3, Violated: false},
+		{Name: "maxunavailablepercent", PDB: maxUnavailable(intstr.FromString("100%")), Replicas: 3, Violated: false},
+		{Name: "alreadyzero", PDB: minAvailable(intstr.FromInt32(1)), Replicas: 0, Violated: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			reason, err := k8s.ScaleToZeroViolation(tt.PDB, tt.Replicas)
+			require.NoError(t, err)
+			require.Equal(t, tt.Violated, reason != "")
+		})
+	}
+}
+
+func TestDisruptionBudgetViolations(t *testing.T) {
+	replicas := int32(2)
+	one := intstr.FromInt32(1)
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "foo"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db"}},
+			},
+		},
+	}
+	matching := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-pdb", Namespace: "foo"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &one,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		},
+	}
+	other := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-pdb", Namespace: "foo"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &one,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+
+	client := fake.NewSimpleClientset(sts, matching, other)
+	violations, err := k8s.DisruptionBudgetViolations(context.Background(), client, "foo", "db")
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Contains(t, violations[0], "db-pdb")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -73,3 +74,27 @@ func SetPVRetainPolicy(ctx context.Context, client kubernetes.Interface, pvName
 	})
 	return originalPolicy, err
 }
+
+// DeletePVandWait drops a pv and waits until it no longer exists
+// whatever happens to the backing volume is up to the pv's reclaim policy
+func DeletePVandWait(ctx context.Context, client kubernetes.Interface, pvName string) error {
+	slog.DebugContext(ctx, "Dropping PV", "name", pvName)
+	err := client.CoreV1().PersistentVolumes().Delete(ctx, pvName, metav1.DeleteOptions{})
+	if k8errors.IsNotFound(err) {
+		slog.InfoContext(ctx, "PV already deleted - skipping delete", "pv", pvName)
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "Unable to drop pv")
+	}
+
+	return wait.PollUntilContextTimeout(ctx, 2*time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
+		_, err := client.CoreV1().PersistentVolumes().Get(ctx, pvName, metav1.GetOptions{})
+		if err == nil {
+			return false, nil
+		}
+		if k8errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
@@ -71,3 +71,24 @@ func DeletePVCandWait(ctx context.Context, client kubernetes.Interface, ns strin
 	})
 	return err
 }
+
+// ClaimNode finds the node a running pod has the claim mounted on, or an empty string if nothing mounts it
+// a job reading a live ReadWriteOnce volume has to run on that node
+func ClaimNode(ctx context.Context, client kubernetes.Interface, ns, claim string) (string, error) {
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to list pods")
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claim {
+				return pod.Spec.NodeName, nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,115 @@
+package k8s
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+var volumeSnapshotGVR = schema.GroupVersionResource{
+	Group:    util.SnapshotAPIGroup,
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
+// ErrForeignSnapshot is returned when a VolumeSnapshot with the requested name snapshots another pvc
+var ErrForeignSnapshot = errors.New("VolumeSnapshot belongs to another pvc")
+
+// CreateSnapshotAndWait snapshots the pvc and waits until the snapshot is readyToUse
+// an empty class uses the cluster's default VolumeSnapshotClass. An existing snapshot of the same name is only
+// adopted (e.g. by a retried activity) if it snapshots the same pvc and isn't being deleted
+func CreateSnapshotAndWait(ctx context.Context, client dynamic.Interface, ns, name, pvc, class string, timeout time.Duration) error {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvc,
+		},
+	}
+	if class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+	snapshot := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": util.SnapshotAPIGroup + "/v1",
+		"kind":       "VolumeSnapshot",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": ns,
+		},
+		"spec": spec,
+	}}
+
+	slog.DebugContext(ctx, "Creating VolumeSnapshot", "name", name, "pvc", pvc, "class", class)
+	_, err := client.Resource(volumeSnapshotGVR).Namespace(ns).Create(ctx, snapshot, metav1.CreateOptions{})
+	if k8errors.IsAlreadyExists(err) {
+		err = checkExistingSnapshot(ctx, client, ns, name, pvc)
+	}
+	if err != nil {
+		return errors.Wrap(err, "Could not create VolumeSnapshot")
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		current, err := client.Resource(volumeSnapshotGVR).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		if message, found, _ := unstructured.NestedString(current.Object, "status", "error", "message"); found {
+			return false, errors.Errorf("VolumeSnapshot failed: %s", message)
+		}
+
+		ready, _, _ := unstructured.NestedBool(current.Object, "status", "readyToUse")
+		slog.DebugContext(ctx, "Polling for snapshot state", "name", name, "ready", ready)
+		return ready, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "VolumeSnapshot never became ready")
+	}
+	return nil
+}
+
+func checkExistingSnapshot(ctx context.Context, client dynamic.Interface, ns, name, pvc string) error {
+	existing, err := client.Resource(volumeSnapshotGVR).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if existing.GetDeletionTimestamp() != nil {
+		return errors.Errorf("VolumeSnapshot %s is still being deleted", name)
+	}
+	source, _, _ := unstructured.NestedString(existing.Object, "spec", "source", "persistentVolumeClaimName")
+	if source != pvc {
+		return errors.Wrapf(ErrForeignSnapshot, "VolumeSnapshot %s snapshots %q rather than %s", name, source, pvc)
+	}
+	slog.InfoContext(ctx, "VolumeSnapshot already exists - adopting it", "name", name, "pvc", pvc)
+	return nil
+}
+
+// DeleteSnapshot drops a VolumeSnapshot and waits until it is gone
+// whatever happens to the content is up to its deletion policy
+func DeleteSnapshot(ctx context.Context, client dynamic.Interface, ns, name string, timeout time.Duration) error {
+	slog.DebugContext(ctx, "Dropping VolumeSnapshot", "name", name)
+	err := client.Resource(volumeSnapshotGVR).Namespace(ns).Delete(ctx, name, metav1.DeleteOptions{})
+	if k8errors.IsNotFound(err) {
+		slog.InfoContext(ctx, "VolumeSnapshot already deleted - skipping delete", "name", name)
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "Unable to drop VolumeSnapshot")
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := client.Resource(volumeSnapshotGVR).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if k8errors.IsNotFound(err) {
+			return true, nil
+		}
+		slog.DebugContext(ctx, "Polling for deleted snapshot state", "name", name)
+		return false, err
+	})
+	return errors.Wrap(err, "VolumeSnapshot was never deleted")
+}
@@ -0,0 +1,59 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestCreateSnapshotAndWaitExisting(t *testing.T) {
+	snapshot := func(pvc string, deleting bool) *unstructured.Unstructured {
+		s := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "snapshot.storage.k8s.io/v1",
+			"kind":       "VolumeSnapshot",
+			"metadata":   map[string]interface{}{"name": "data-db-0-pvscope-12345678-safety", "namespace": "db"},
+			"spec":       map[string]interface{}{"source": map[string]interface{}{"persistentVolumeClaimName": pvc}},
+			"status":     map[string]interface{}{"readyToUse": true},
+		}}
+		if deleting {
+			now := metav1.Now()
+			s.SetDeletionTimestamp(&now)
+			s.SetFinalizers([]string{"snapshot.storage.kubernetes.io/volumesnapshot-as-source-protection"})
+		}
+		return s
+	}
+
+	testCases := []struct {
+		Name     string
+		Existing *unstructured.Unstructured
+		Foreign  bool
+		Error    bool
+	}{
+		{Name: "retried", Existing: snapshot("data-db-0", false)},
+		{Name: "foreign", Existing: snapshot("data-db-1", false), Foreign: true, Error: true},
+		{Name: "terminating", Existing: snapshot("data-db-0", true), Error: true},
+	}
+
+	gvr := schema.GroupVersionResource{Group: "snapshot.storage.k8s.io", Version: "v1", Resource: "volumesnapshots"}
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "VolumeSnapshotList"}, tt.Existing)
+			err := k8s.CreateSnapshotAndWait(context.Background(), client, "db", "data-db-0-pvscope-12345678-safety", "data-db-0", "", 10*time.Second)
+			if !tt.Error {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tt.Foreign, errors.Is(err, k8s.ErrForeignSnapshot))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+// the parts of the kubelet's /stats/summary (stats/v1alpha1) we need
+type statsSummary struct {
+	Node struct {
+		NodeName string `json:"nodeName"`
+	} `json:"node"`
+	Pods []struct {
+		Volumes []struct {
+			UsedBytes     *int64  `json:"usedBytes"`
+			CapacityBytes *int64  `json:"capacityBytes"`
+			InodesUsed    *uint64 `json:"inodesUsed"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// NodeVolumeStats reads the volume stats of a node's kubelet through the api server's node proxy
+func NodeVolumeStats(ctx context.Context, client kubernetes.Interface, node string) ([]util.VolumeStats, error) {
+	data, err := client.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(node).SubResource("proxy").Suffix("stats/summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get stats summary of node %s", node)
+	}
+
+	stats, err := ParseVolumeStats(data)
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "Read volume stats", "node", node, "volumes", len(stats))
+	return stats, nil
+}
+
+// ParseVolumeStats picks the pvc volumes out of a stats summary
+// a pvc mounted by several pods on the node is only reported once
+func ParseVolumeStats(data []byte) ([]util.VolumeStats, error) {
+	summary := statsSummary{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, errors.Wrap(err, "failed to parse stats summary")
+	}
+
+	seen := map[string]bool{}
+	stats := []util.VolumeStats{}
+	for _, pod := range summary.Pods {
+		for _, volume := range pod.Volumes {
+			if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil {
+				continue
+			}
+			key := volume.PVCRef.Namespace + "/" + volume.PVCRef.Name
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			s := util.VolumeStats{
+				UsedBytes:     *volume.UsedBytes,
+				CapacityBytes: *volume.CapacityBytes,
+				Namespace:     volume.PVCRef.Namespace,
+				PVC:           volume.PVCRef.Name,
+				Node:          summary.Node.NodeName,
+			}
+			if volume.InodesUsed != nil {
+				s.InodesUsed = *volume.InodesUsed
+			}
+			stats = append(stats, s)
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,45 @@
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVolumeStats(t *testing.T) {
+	summary := `{
+		"node": {"nodeName": "node-a"},
+		"pods": [
+			{
+				"podRef": {"name": "db-0", "namespace": "foo"},
+				"volume": [
+					{"name": "kube-api-access", "usedBytes": 12, "capacityBytes": 100},
+					{"name": "data", "usedBytes": 1073741824, "capacityBytes": 10737418240, "inodesUsed": 42, "pvcRef": {"name": "data-db-0", "namespace": "foo"}}
+				]
+			},
+			{
+				"podRef": {"name": "backup", "namespace": "foo"},
+				"volume": [
+					{"name": "data", "usedBytes": 1073741824, "capacityBytes": 10737418240, "pvcRef": {"name": "data-db-0", "namespace": "foo"}},
+					{"name": "scratch", "pvcRef": {"name": "scratch", "namespace": "foo"}}
+				]
+			}
+		]
+	}`
+
+	stats, err := k8s.ParseVolumeStats([]byte(summary))
+	require.NoError(t, err)
+	require.Equal(t, []util.VolumeStats{{
+		UsedBytes:     1 << 30,
+		CapacityBytes: 10 << 30,
+		InodesUsed:    42,
+		Namespace:     "foo",
+		PVC:           "data-db-0",
+		Node:          "node-a",
+	}}, stats)
+
+	_, err = k8s.ParseVolumeStats([]byte("not json"))
+	require.Error(t, err)
+}
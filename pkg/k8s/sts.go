@@ -4,28 +4,26 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 )
 
 func ScaleSTS(ctx context.Context, client kubernetes.Interface, ns, name string, replicas int32) error {
-	stsClient := client.AppsV1().StatefulSets(ns)
-	sts, err := stsClient.Get(ctx, name, metav1.GetOptions{})
+	err := SetReplicas(ctx, client, ns, name, replicas)
 	if err != nil {
-		return errors.Wrap(err, "failed to get StatefulSet")
-	}
-
-	sts.Spec.Replicas = &replicas
-	_, err = stsClient.Update(ctx, sts, metav1.UpdateOptions{})
-	if err != nil {
-		return errors.Wrap(err, "failed to scale down StatefulSet")
+		return err
 	}
 
 	// Wait for it to be fully scaled
+	stsClient := client.AppsV1().StatefulSets(ns)
 	err = wait.PollUntilContextTimeout(ctx, 5*time.Second, 5*time.Minute, true, func(ctx context.Context) (bool, error) {
 		current, err := stsClient.Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
@@ -43,6 +41,31 @@ func ScaleSTS(ctx context.Context, client kubernetes.Interface, ns, name string,
 	return nil
 }
 
+// SetReplicas changes the replicas of the statefulset without waiting for the pods
+func SetReplicas(ctx context.Context, client kubernetes.Interface, ns, name string, replicas int32) error {
+	stsClient := client.AppsV1().StatefulSets(ns)
+	sts, err := stsClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	sts.Spec.Replicas = &replicas
+	_, err = stsClient.Update(ctx, sts, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to scale StatefulSet")
+	}
+	return nil
+}
+
+// STSReady reports whether every replica of the statefulset is ready
+func STSReady(ctx context.Context, client kubernetes.Interface, ns, name string, replicas int32) (bool, error) {
+	sts, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get StatefulSet")
+	}
+	return sts.Status.Replicas == replicas && sts.Status.ReadyReplicas == replicas, nil
+}
+
 func GetReplicas(ctx context.Context, client kubernetes.Interface, ns, name string) (int32, error) {
 	stsClient := client.AppsV1().StatefulSets(ns)
 
@@ -59,3 +82,151 @@ func GetReplicas(ctx context.Context, client kubernetes.Interface, ns, name stri
 	slog.DebugContext(ctx, "Found replicas", "count", originalReplicas)
 	return originalReplicas, nil
 }
+
+// GetSTSPods lists the pods currently owned by the statefulset (by its selector)
+func GetSTSPods(ctx context.Context, client kubernetes.Interface, ns, name string) ([]corev1.Pod, error) {
+	sts, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get StatefulSet")
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid StatefulSet selector")
+	}
+
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list StatefulSet pods")
+	}
+	return pods.Items, nil
+}
+
+func GetSTSAnnotations(ctx context.Context, client kubernetes.Interface, ns, name string) (map[string]string, error) {
+	sts, err := client.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get StatefulSet")
+	}
+	return sts.Annotations, nil
+}
+
+// RestartCounts sums the container restarts of each pod
+func RestartCounts(pods []corev1.Pod) map[string]int32 {
+	restarts := map[string]int32{}
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts[pod.Name] += status.RestartCount
+		}
+	}
+	return restarts
+}
+
+// STSHealthProblem describes why the statefulset's pods aren't healthy or returns an empty string if they are
+// baseline holds the restart counts seen when monitoring started - any restart since then is a problem
+func STSHealthProblem(ctx context.Context, client kubernetes.Interface, ns, name string, replicas int32, baseline map[string]int32) (string, error) {
+	pods, err := GetSTSPods(ctx, client, ns, name)
+	if err != nil {
+		return "", err
+	}
+
+	if int32(len(pods)) < replicas {
+		return fmt.Sprintf("only %d of %d pods exist", len(pods), replicas), nil
+	}
+
+	if problem := CrashLoopProblem(pods); problem != "" {
+		return problem, nil
+	}
+
+	restarts := RestartCounts(pods)
+	for _, pod := range pods {
+		if restarts[pod.Name] > baseline[pod.Name] {
+			return fmt.Sprintf("pod %s restarted %d times", pod.Name, restarts[pod.Name]-baseline[pod.Name]), nil
+		}
+
+		ready := false
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				ready = true
+			}
+		}
+		if !ready {
+			return fmt.Sprintf("pod %s is not ready", pod.Name), nil
+		}
+	}
+
+	slog.DebugContext(ctx, "StatefulSet healthy", "name", name, "pods", len(pods))
+	return "", nil
+}
+
+// CrashLoopProblem names the first crashlooping container of the pods or returns an empty string if none is
+func CrashLoopProblem(pods []corev1.Pod) string {
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+				return fmt.Sprintf("container %s in pod %s is crashlooping", status.Name, pod.Name)
+			}
+		}
+	}
+	return ""
+}
+
+// ReplicaClaimName is the name the statefulset controller gives the claim of a volumeClaimTemplate for an ordinal
+func ReplicaClaimName(template, sts string, ordinal int32) string {
+	return fmt.Sprintf("%s-%s-%d", template, sts, ordinal)
+}
+
+// ReplicaClaim renders the claim the statefulset controller would create (and later adopt) for an ordinal
+func ReplicaClaim(sts *appsv1.StatefulSet, template string, ordinal int32) (*corev1.PersistentVolumeClaim, error) {
+	for _, t := range sts.Spec.VolumeClaimTemplates {
+		if t.Name != template {
+			continue
+		}
+
+		claim := t.DeepCopy()
+		claim.Name = ReplicaClaimName(template, sts.Name, ordinal)
+		claim.Namespace = sts.Namespace
+		if claim.Labels == nil {
+			claim.Labels = map[string]string{}
+		}
+		if sts.Spec.Selector != nil {
+			for k, v := range sts.Spec.Selector.MatchLabels {
+				claim.Labels[k] = v
+			}
+		}
+		return claim, nil
+	}
+	return nil, errors.Errorf("StatefulSet %s has no volumeClaimTemplate %s", sts.Name, template)
+}
+
+// ClaimOwner finds the statefulset whose volumeClaimTemplates produce the claim
+// it works from the templates so it also finds statefulsets that are scaled to 0
+func ClaimOwner(ctx context.Context, client kubernetes.Interface, ns, claim string) (string, error) {
+	stss, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list StatefulSets")
+	}
+
+	owners := TemplateOwners(stss.Items, claim)
+	switch len(owners) {
+	case 0:
+		return "", errors.Errorf("no StatefulSet in %s owns pvc %s", ns, claim)
+	case 1:
+		return owners[0], nil
+	default:
+		return "", errors.Errorf("pvc %s matches several StatefulSets: %s", claim, strings.Join(owners, ", "))
+	}
+}
+
+// TemplateOwners lists the statefulsets that would name one of their replica claims `claim`
+func TemplateOwners(stss []appsv1.StatefulSet, claim string) []string {
+	owners := []string{}
+	for _, sts := range stss {
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			ordinal, found := strings.CutPrefix(claim, fmt.Sprintf("%s-%s-", t.Name, sts.Name))
+			if _, err := strconv.ParseInt(ordinal, 10, 32); found && err == nil {
+				owners = append(owners, sts.Name)
+			}
+		}
+	}
+	return owners
+}
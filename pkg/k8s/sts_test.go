@@ -0,0 +1,148 @@
+package k8s_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestReplicaClaim(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "foo"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data", Labels: map[string]string{"tier": "storage"}}},
+			},
+		},
+	}
+
+	claim, err := k8s.ReplicaClaim(sts, "data", 3)
+	require.NoError(t, err)
+	require.Equal(t, "data-db-3", claim.Name)
+	require.Equal(t, "foo", claim.Namespace)
+	require.Equal(t, map[string]string{"app": "db", "tier": "storage"}, claim.Labels)
+	// the template itself must not pick up the selector labels
+	require.Len(t, sts.Spec.VolumeClaimTemplates[0].Labels, 1)
+
+	_, err = k8s.ReplicaClaim(sts, "missing", 3)
+	require.Error(t, err)
+}
+
+func TestClaimOwner(t *testing.T) {
+	sts := func(name string, templates ...string) *appsv1.StatefulSet {
+		s := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foo"}}
+		for _, template := range templates {
+			s.Spec.VolumeClaimTemplates = append(s.Spec.VolumeClaimTemplates, corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: template}})
+		}
+		return s
+	}
+	client := fake.NewClientset(sts("db", "data", "wal"), sts("db-replica", "data"), sts("data-db", "x"))
+
+	testCases := []struct {
+		Name  string
+		Claim string
+		Owner string
+		Error string
+	}{
+		{Name: "owned", Claim: "data-db-0", Owner: "db"},
+		{Name: "secondtemplate", Claim: "wal-db-12", Owner: "db"},
+		{Name: "dashedsts", Claim: "data-db-replica-1", Owner: "db-replica"},
+		{Name: "notordinal", Claim: "data-db-primary", Error: "no StatefulSet"},
+		{Name: "unowned", Claim: "scratch", Error: "no StatefulSet"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			owner, err := k8s.ClaimOwner(context.Background(), client, "foo", tt.Claim)
+			if tt.Error != "" {
+				require.ErrorContains(t, err, tt.Error)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.Owner, owner)
+		})
+	}
+}
+
+func TestRestartCounts(t *testing.T) {
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-0"},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "db", RestartCount: 2},
+				{Name: "exporter", RestartCount: 1},
+			}},
+		},
+		{ObjectMeta: metav1.ObjectMeta{Name: "db-1"}},
+	}
+	require.Equal(t, map[string]int32{"db-0": 3}, k8s.RestartCounts(pods))
+}
+
+func TestSTSHealthProblem(t *testing.T) {
+	pod := func(name string, ready bool, restarts int32, waiting string) *corev1.Pod {
+		p := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foo", Labels: map[string]string{"app": "db"}}}
+		status := corev1.ContainerStatus{Name: "db", RestartCount: restarts}
+		if waiting != "" {
+			status.State.Waiting = &corev1.ContainerStateWaiting{Reason: waiting}
+		}
+		p.Status.ContainerStatuses = []corev1.ContainerStatus{status}
+		condition := corev1.ConditionFalse
+		if ready {
+			condition = corev1.ConditionTrue
+		}
+		p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: condition}}
+		return p
+	}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "foo"},
+		Spec:       appsv1.StatefulSetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+	}
+
+	testCases := []struct {
+		Name     string
+		Pods     []*corev1.Pod
+		Baseline map[string]int32
+		Problem  string
+	}{
+		{Name: "healthy", Pods: []*corev1.Pod{pod("db-0", true, 0, ""), pod("db-1", true, 0, "")}},
+		{Name: "missing", Pods: []*corev1.Pod{pod("db-0", true, 0, "")}, Problem: "only 1 of 2 pods exist"},
+		{
+			Name:    "crashloop",
+			Pods:    []*corev1.Pod{pod("db-0", true, 0, ""), pod("db-1", false, 4, "CrashLoopBackOff")},
+			Problem: "container db in pod db-1 is crashlooping",
+		},
+		{
+			Name:     "restarted",
+			Pods:     []*corev1.Pod{pod("db-0", true, 3, ""), pod("db-1", true, 0, "")},
+			Baseline: map[string]int32{"db-0": 1},
+			Problem:  "pod db-0 restarted 2 times",
+		},
+		{
+			Name:     "oldrestarts",
+			Pods:     []*corev1.Pod{pod("db-0", true, 3, ""), pod("db-1", true, 0, "")},
+			Baseline: map[string]int32{"db-0": 3},
+		},
+		{Name: "unready", Pods: []*corev1.Pod{pod("db-0", true, 0, ""), pod("db-1", false, 0, "")}, Problem: "pod db-1 is not ready"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			client := fake.NewClientset(sts)
+			for _, p := range tt.Pods {
+				_, err := client.CoreV1().Pods("foo").Create(context.Background(), p, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			problem, err := k8s.STSHealthProblem(context.Background(), client, "foo", "db", 2, tt.Baseline)
+			require.NoError(t, err)
+			require.Equal(t, tt.Problem, problem)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AutoLabel opts a namespace into scheduled campaigns
+const AutoLabel = "down-pvscope.io/auto"
+
+// CampaignReportKey is the ConfigMap key holding the latest CampaignReport
+const CampaignReportKey = "report.json"
+
+// ValidateCampaign checks a campaign before it's scheduled or run
+func ValidateCampaign(campaign *proto.Campaign) error {
+	if campaign.Scan == nil || (len(campaign.Scan.Namespaces) == 0 && campaign.Scan.NamespaceSelector == "") {
+		return errors.New("invalid campaign: the scan needs namespaces or a namespace_selector")
+	}
+	if _, err := ScanLimits(campaign.Scan); err != nil {
+		return errors.Wrap(err, "invalid campaign")
+	}
+	if campaign.MaxUsage < 0 || campaign.MaxUsage > 1 {
+		return errors.Errorf("invalid campaign: max_usage %v must be between 0 and 1", campaign.MaxUsage)
+	}
+	if campaign.ReportConfigmap != "" && campaign.ReportNamespace == "" {
+		return errors.New("invalid campaign: report_configmap needs a report_namespace")
+	}
+	return nil
+}
+
+// SelectForCampaign keeps the recommendations using less than maxUsage of their current size
+// every recommendation is kept when maxUsage is 0
+func SelectForCampaign(recommendations []*proto.Recommendation, maxUsage float64) ([]*proto.Recommendation, error) {
+	selected := []*proto.Recommendation{}
+	for _, r := range recommendations {
+		if maxUsage == 0 {
+			selected = append(selected, r)
+			continue
+		}
+		current, err := resource.ParseQuantity(r.CurrentSize)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to parse size of pvc %s/%s", r.Namespace, r.Pvc)
+		}
+		if float64(r.UsedBytes) < maxUsage*float64(current.Value()) {
+			selected = append(selected, r)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,52 @@
+package util_test
+
+import (
+	"testing"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCampaign(t *testing.T) {
+	selector := &proto.Scan{NamespaceSelector: util.AutoLabel + "=true"}
+
+	testCases := []struct {
+		Name     string
+		Campaign *proto.Campaign
+		Problem  string
+	}{
+		{Name: "valid", Campaign: &proto.Campaign{Scan: selector, MaxUsage: 0.5}},
+		{Name: "noscan", Campaign: &proto.Campaign{}, Problem: "needs namespaces"},
+		{Name: "usage", Campaign: &proto.Campaign{Scan: selector, MaxUsage: 50}, Problem: "between 0 and 1"},
+		{Name: "reclaim", Campaign: &proto.Campaign{Scan: &proto.Scan{Namespaces: []string{"db"}, MinReclaim: "lots"}}, Problem: "min_reclaim"},
+		{Name: "report", Campaign: &proto.Campaign{Scan: selector, ReportConfigmap: "pvscope-report"}, Problem: "needs a report_namespace"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := util.ValidateCampaign(tt.Campaign)
+			if tt.Problem == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.Problem)
+		})
+	}
+}
+
+func TestSelectForCampaign(t *testing.T) {
+	recommendations := []*proto.Recommendation{
+		{Pvc: "mostlyempty", CurrentSize: "100Gi", UsedBytes: 10 << 30},
+		{Pvc: "halffull", CurrentSize: "100Gi", UsedBytes: 50 << 30},
+	}
+
+	selected, err := util.SelectForCampaign(recommendations, 0.5)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, "mostlyempty", selected[0].Pvc)
+
+	selected, err = util.SelectForCampaign(recommendations, 0)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+}
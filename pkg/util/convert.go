@@ -0,0 +1,44 @@
+package util
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+)
+
+// ScaleRequestFromV1 converts a v1 Scale payload into the equivalent v2 ScaleRequest
+func ScaleRequestFromV1(scale *proto.Scale) *protov2.ScaleRequest {
+	return &protov2.ScaleRequest{
+		Workload: &protov2.WorkloadRef{
+			Namespace:   scale.Namespace,
+			Statefulset: scale.Sts,
+		},
+		Target: &protov2.Target{
+			Pvc:  scale.Pvc,
+			Size: scale.Size,
+		},
+		Options: &protov2.Options{
+			Safety: &protov2.SafetyOptions{
+				AllowDisruption:   scale.AllowDisruption,
+				MaxDowntime:       scale.MaxDowntime,
+				MaintenanceWindow: scale.MaintenanceWindow,
+				Approval:          scale.Approval,
+				Breakpoints:       scale.Breakpoints,
+			},
+			Hooks: &protov2.HookOptions{
+				PreQuiesce: scale.PreQuiesceHooks,
+				PostResume: scale.PostResumeHooks,
+			},
+			Mover: &protov2.MoverOptions{
+				CopyMode:             scale.CopyMode,
+				PrecopySnapshotClass: scale.PrecopySnapshotClass,
+			},
+			Verification: scale.Verification,
+			Retention: &protov2.RetentionOptions{
+				RetainOriginalFor: scale.RetainOriginalFor,
+				Snapshot:          scale.Snapshot,
+				Backup:            scale.Backup,
+				PersistResult:     scale.PersistResult,
+			},
+		},
+	}
+}
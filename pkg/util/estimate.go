@@ -0,0 +1,46 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// perFileOverhead is what rclone spends per file on top of moving its bytes (stat, open, set times)
+	// it's also all a delta sync costs for an unchanged file
+	perFileOverhead = 2 * time.Millisecond
+	// fixedOverhead covers scaling the sts down and up, scheduling the copy job and rebinding the claim
+	fixedOverhead = 2 * time.Minute
+)
+
+// assumedReadBytesPerSecond is a deliberately pessimistic throughput for volumes that weren't sampled
+const assumedReadBytesPerSecond = 50 << 20
+
+// CopySample is what a sampling job measured on a volume
+type CopySample struct {
+	Bytes int64 `json:"bytes"`
+	Files int64 `json:"files"`
+	// ReadBytesPerSecond is the sequential read throughput seen while sampling
+	ReadBytesPerSecond int64 `json:"readBytesPerSecond"`
+}
+
+// EstimateDowntime predicts how long the sts stays at 0 replicas
+// after a pre-copy only the per file cost of the delta sync is left
+func EstimateDowntime(sample CopySample, precopy bool) time.Duration {
+	estimate := fixedOverhead + time.Duration(sample.Files)*perFileOverhead
+	if precopy {
+		return estimate
+	}
+
+	if sample.ReadBytesPerSecond <= 0 {
+		// nothing could be read while sampling - assume the worst
+		return time.Duration(math.MaxInt64)
+	}
+	return estimate + time.Duration(float64(sample.Bytes)/float64(sample.ReadBytesPerSecond)*float64(time.Second))
+}
+
+// AssumedSample stands in for a sample when none can be taken - a volume full to `capacity` bytes
+// of large files read at a pessimistic throughput
+func AssumedSample(capacity int64) CopySample {
+	return CopySample{Bytes: capacity, ReadBytesPerSecond: assumedReadBytesPerSecond}
+}
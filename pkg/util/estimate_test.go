@@ -0,0 +1,47 @@
+package util_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateDowntime(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Sample   util.CopySample
+		Precopy  bool
+		Expected time.Duration
+	}{
+		{
+			Name:     "offline",
+			Sample:   util.CopySample{Bytes: 100 << 20, Files: 1000, ReadBytesPerSecond: 10 << 20},
+			Expected: 2*time.Minute + 2*time.Second + 10*time.Second,
+		},
+		{
+			Name:     "precopy",
+			Sample:   util.CopySample{Bytes: 100 << 20, Files: 1000, ReadBytesPerSecond: 10 << 20},
+			Precopy:  true,
+			Expected: 2*time.Minute + 2*time.Second,
+		},
+		{
+			Name:     "assumed",
+			Sample:   util.AssumedSample(500 << 20),
+			Expected: 2*time.Minute + 10*time.Second,
+		},
+		{
+			Name:     "unreadable",
+			Sample:   util.CopySample{Bytes: 100 << 20, Files: 1000},
+			Expected: time.Duration(math.MaxInt64),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Expected, util.EstimateDowntime(tt.Sample, tt.Precopy))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+// FleetQuery returns the FleetReport of a fleet so far
+const FleetQuery = "fleet"
+
+// ResizeWorkflowID is the id of the resize of a pvc - only one can run per pvc at a time
+// neither a namespace nor a pvc name can contain a slash, so ids of different pvcs never collide
+func ResizeWorkflowID(ns, pvc string) string {
+	return fmt.Sprintf("pvscope/%s/%s", ns, pvc)
+}
+
+// ParseFleet decodes a Fleet from proto json or the same structure in yaml
+func ParseFleet(data []byte) (*proto.Fleet, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to parse fleet")
+	}
+
+	fleet := &proto.Fleet{}
+	if err := protojson.Unmarshal(data, fleet); err != nil {
+		return nil, errors.Wrap(err, "Unable to parse fleet")
+	}
+	return fleet, nil
+}
+
+// ValidateFleet validates every target and makes sure no pvc is resized twice
+// targets can't retain their original pv since the child would hold a slot of the fleet for days
+func ValidateFleet(fleet *proto.Fleet) error {
+	if len(fleet.Targets) == 0 && len(fleet.Outcomes) == 0 {
+		return errors.New("invalid fleet: no targets")
+	}
+
+	problems := []string{}
+	seen := map[string]bool{}
+	for i, target := range fleet.Targets {
+		id := ResizeWorkflowID(target.Namespace, target.Pvc)
+		if seen[id] {
+			problems = append(problems, fmt.Sprintf("targets[%d]: pvc %s/%s is listed twice", i, target.Namespace, target.Pvc))
+		}
+		seen[id] = true
+		if err := ValidateScaleRequest(ScaleRequestFromV1(target)); err != nil {
+			problems = append(problems, fmt.Sprintf("targets[%d]: %s", i, err))
+		}
+		// a child waiting out the retention period would hold its slot for all of it
+		if target.RetainOriginalFor.AsDuration() > 0 {
+			problems = append(problems, fmt.Sprintf("targets[%d]: retain_original_for isn't supported in a fleet - resize %s/%s on its own", i, target.Namespace, target.Pvc))
+		}
+	}
+	if fleet.MaxConcurrency < 0 || fleet.MaxPerNamespace < 0 || fleet.MaxFailures < 0 {
+		problems = append(problems, "limits must not be negative")
+	}
+
+	if len(problems) > 0 {
+		return errors.New("invalid fleet: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// SummarizeFleet counts the outcomes into a report
+func SummarizeFleet(outcomes []*proto.FleetOutcome, remaining int) *proto.FleetReport {
+	report := &proto.FleetReport{Outcomes: outcomes, Remaining: int32(remaining)}
+	for _, outcome := range outcomes {
+		switch outcome.Status {
+		case proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED:
+			report.Succeeded++
+		case proto.FleetTargetStatus_FLEET_TARGET_STATUS_FAILED:
+			report.Failed++
+		case proto.FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED:
+			report.Skipped++
+		}
+	}
+	return report
+}
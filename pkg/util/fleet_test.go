@@ -0,0 +1,82 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestParseFleet(t *testing.T) {
+	yamlFleet := `
+maxConcurrency: 4
+max_per_namespace: 1
+targets:
+  - {namespace: foo, pvc: data-db-0, size: 10Gi, sts: db}
+  - namespace: bar
+    pvc: data-db-0
+    size: 5Gi
+    sts: db
+    maxDowntime: 600s
+`
+	fleet, err := util.ParseFleet([]byte(yamlFleet))
+	require.NoError(t, err)
+	require.EqualValues(t, 4, fleet.MaxConcurrency)
+	require.EqualValues(t, 1, fleet.MaxPerNamespace)
+	require.Len(t, fleet.Targets, 2)
+	require.Equal(t, "bar", fleet.Targets[1].Namespace)
+	require.EqualValues(t, 600, fleet.Targets[1].MaxDowntime.Seconds)
+
+	jsonFleet, err := util.ParseFleet([]byte(`{"targets": [{"namespace": "foo", "pvc": "data-db-0", "size": "10Gi", "sts": "db"}]}`))
+	require.NoError(t, err)
+	require.Len(t, jsonFleet.Targets, 1)
+
+	_, err = util.ParseFleet([]byte(`{"targets": [{"volume": "data"}]}`))
+	require.Error(t, err)
+}
+
+func TestValidateFleet(t *testing.T) {
+	target := func(ns, pvc string) *proto.Scale {
+		return &proto.Scale{Namespace: ns, Pvc: pvc, Size: "10Gi", Sts: "db"}
+	}
+
+	testCases := []struct {
+		Name    string
+		Fleet   *proto.Fleet
+		Problem string
+	}{
+		{Name: "valid", Fleet: &proto.Fleet{Targets: []*proto.Scale{target("foo", "data-db-0"), target("bar", "data-db-0")}}},
+		{Name: "empty", Fleet: &proto.Fleet{}, Problem: "no targets"},
+		{Name: "twice", Fleet: &proto.Fleet{Targets: []*proto.Scale{target("foo", "data-db-0"), target("foo", "data-db-0")}}, Problem: "listed twice"},
+		{Name: "badtarget", Fleet: &proto.Fleet{Targets: []*proto.Scale{target("foo", "")}}, Problem: "targets[0]: invalid scale request"},
+		{Name: "retention", Fleet: &proto.Fleet{Targets: []*proto.Scale{{Namespace: "foo", Pvc: "data-db-0", Size: "10Gi", Sts: "db", RetainOriginalFor: durationpb.New(24 * time.Hour)}}}, Problem: "targets[0]: retain_original_for isn't supported"},
+		{Name: "negative", Fleet: &proto.Fleet{Targets: []*proto.Scale{target("foo", "data-db-0")}, MaxFailures: -1}, Problem: "must not be negative"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := util.ValidateFleet(tt.Fleet)
+			if tt.Problem == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.Problem)
+		})
+	}
+}
+
+func TestSummarizeFleet(t *testing.T) {
+	report := util.SummarizeFleet([]*proto.FleetOutcome{
+		{Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED},
+		{Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED},
+		{Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_FAILED},
+		{Status: proto.FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED},
+	}, 3)
+	require.EqualValues(t, 2, report.Succeeded)
+	require.EqualValues(t, 1, report.Failed)
+	require.EqualValues(t, 1, report.Skipped)
+	require.EqualValues(t, 3, report.Remaining)
+}
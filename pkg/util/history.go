@@ -0,0 +1,181 @@
+package util
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+)
+
+const (
+	// UsageHistoryConfigMap holds the usage history of every pvc in its namespace, keyed by pvc name
+	UsageHistoryConfigMap = "down-pvscope-usage"
+	// DefaultUsageRetention is how long usage samples are kept
+	DefaultUsageRetention = 180 * 24 * time.Hour
+	// DefaultGrowthHorizon is how far ahead recommendations project growth
+	DefaultGrowthHorizon = 90 * 24 * time.Hour
+	// samples older than this are thinned out to the largest of each day
+	dailyAfter = 14 * 24 * time.Hour
+	// growth isn't projected from a shorter history, it would mostly be noise
+	minGrowthSpan = 7 * 24 * time.Hour
+)
+
+// VolumeStats is the usage the kubelet reports for a pvc mounted on its node
+type VolumeStats struct {
+	UsedBytes     int64  `json:"usedBytes"`
+	CapacityBytes int64  `json:"capacityBytes"`
+	InodesUsed    uint64 `json:"inodesUsed"`
+	Namespace     string `json:"namespace"`
+	PVC           string `json:"pvc"`
+	Node          string `json:"node"`
+}
+
+// UsageSample is the used bytes of a pvc at a point in time
+type UsageSample struct {
+	At        time.Time
+	UsedBytes int64
+}
+
+// EncodeUsageHistory packs samples as space separated <unix seconds>:<used bytes> to keep ConfigMaps small
+func EncodeUsageHistory(samples []UsageSample) string {
+	parts := make([]string, 0, len(samples))
+	for _, s := range samples {
+		parts = append(parts, strconv.FormatInt(s.At.Unix(), 10)+":"+strconv.FormatInt(s.UsedBytes, 10))
+	}
+	return strings.Join(parts, " ")
+}
+
+// DecodeUsageHistory unpacks EncodeUsageHistory's format
+func DecodeUsageHistory(value string) ([]UsageSample, error) {
+	samples := []UsageSample{}
+	for _, part := range strings.Fields(value) {
+		at, used, found := strings.Cut(part, ":")
+		if !found {
+			return nil, errors.Errorf("malformed usage sample %q", part)
+		}
+		unix, err := strconv.ParseInt(at, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed usage sample %q", part)
+		}
+		bytes, err := strconv.ParseInt(used, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed usage sample %q", part)
+		}
+		samples = append(samples, UsageSample{At: time.Unix(unix, 0).UTC(), UsedBytes: bytes})
+	}
+	return samples, nil
+}
+
+// DecodeUsageHistories unpacks the history of every pvc in a usage history ConfigMap's data
+// a damaged entry doesn't spoil the others - it's left out of the histories and its error returned by pvc
+func DecodeUsageHistories(data map[string]string) (map[string][]UsageSample, map[string]error) {
+	histories := map[string][]UsageSample{}
+	unreadable := map[string]error{}
+	for pvc, value := range data {
+		samples, err := DecodeUsageHistory(value)
+		if err != nil {
+			unreadable[pvc] = err
+			continue
+		}
+		histories[pvc] = samples
+	}
+	return histories, unreadable
+}
+
+// CompactUsageHistory sorts the samples, drops the ones older than retention and keeps only the largest
+// sample of each day once they're older than two weeks
+func CompactUsageHistory(samples []UsageSample, now time.Time, retention time.Duration) []UsageSample {
+	samples = slices.Clone(samples)
+	slices.SortFunc(samples, func(a, b UsageSample) int {
+		return a.At.Compare(b.At)
+	})
+
+	compacted := []UsageSample{}
+	for _, s := range samples {
+		age := now.Sub(s.At)
+		if age > retention {
+			continue
+		}
+		if n := len(compacted); age > dailyAfter && n > 0 && sameDay(compacted[n-1].At, s.At) {
+			if s.UsedBytes > compacted[n-1].UsedBytes {
+				compacted[n-1] = s
+			}
+			continue
+		}
+		compacted = append(compacted, s)
+	}
+	return compacted
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.UTC().Truncate(24 * time.Hour).Equal(b.UTC().Truncate(24 * time.Hour))
+}
+
+// GrowthPerDay fits a line through the samples and returns its slope in bytes per day
+// shrinking volumes and histories shorter than a week have no growth
+func GrowthPerDay(samples []UsageSample) int64 {
+	if len(samples) < 2 {
+		return 0
+	}
+	first, last := samples[0].At, samples[0].At
+	for _, s := range samples {
+		if s.At.Before(first) {
+			first = s.At
+		}
+		if s.At.After(last) {
+			last = s.At
+		}
+	}
+	if last.Sub(first) < minGrowthSpan {
+		return 0
+	}
+
+	// least squares with time in days since the first sample
+	var sumX, sumY, sumXY, sumXX float64
+	n := float64(len(samples))
+	for _, s := range samples {
+		x := s.At.Sub(first).Hours() / 24
+		y := float64(s.UsedBytes)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	return int64(math.Max(math.Ceil(slope), 0))
+}
+
+// ProjectUsage is the most the volume is expected to use within the horizon: the current usage grown at the
+// historical rate, and never less than the largest usage seen
+func ProjectUsage(samples []UsageSample, current int64, horizon time.Duration) (int64, int64) {
+	growth := GrowthPerDay(samples)
+	projected := current + int64(float64(growth)*horizon.Hours()/24)
+	for _, s := range samples {
+		projected = max(projected, s.UsedBytes)
+	}
+	return projected, growth
+}
+
+// ValidateUsageCollection checks a collection covers some namespaces and keeps its samples for a while
+func ValidateUsageCollection(collection *proto.UsageCollection) error {
+	if len(collection.Namespaces) == 0 && collection.NamespaceSelector == "" {
+		return errors.New("usage collection needs namespaces or a namespace_selector")
+	}
+	if collection.Retention != nil {
+		if err := collection.Retention.CheckValid(); err != nil {
+			return errors.Wrap(err, "invalid retention")
+		}
+		if collection.Retention.AsDuration() < minGrowthSpan {
+			return errors.Errorf("retention must be at least %s to project growth", minGrowthSpan)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageHistoryEncoding(t *testing.T) {
+	samples := []util.UsageSample{
+		{At: time.Unix(1700000000, 0).UTC(), UsedBytes: 1 << 30},
+		{At: time.Unix(1700003600, 0).UTC(), UsedBytes: 2 << 30},
+	}
+	encoded := util.EncodeUsageHistory(samples)
+	require.Equal(t, "1700000000:1073741824 1700003600:2147483648", encoded)
+
+	decoded, err := util.DecodeUsageHistory(encoded)
+	require.NoError(t, err)
+	require.Equal(t, samples, decoded)
+
+	decoded, err = util.DecodeUsageHistory("")
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	_, err = util.DecodeUsageHistory("1700000000")
+	require.Error(t, err)
+	_, err = util.DecodeUsageHistory("1700000000:lots")
+	require.Error(t, err)
+}
+
+func TestDecodeUsageHistories(t *testing.T) {
+	histories, unreadable := util.DecodeUsageHistories(map[string]string{
+		"data-db-0": "1700000000:1073741824",
+		"data-db-1": "1700000000:lots",
+		"data-db-2": "",
+	})
+	require.Equal(t, map[string][]util.UsageSample{
+		"data-db-0": {{At: time.Unix(1700000000, 0).UTC(), UsedBytes: 1 << 30}},
+		"data-db-2": {},
+	}, histories)
+	require.Len(t, unreadable, 1)
+	require.Error(t, unreadable["data-db-1"])
+}
+
+func TestCompactUsageHistory(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	samples := []util.UsageSample{
+		{At: now, UsedBytes: 9},
+		// past retention
+		{At: now.Add(-200 * day), UsedBytes: 1},
+		// same old day, only the largest is kept
+		{At: now.Add(-30*day - time.Hour), UsedBytes: 3},
+		{At: now.Add(-30 * day), UsedBytes: 5},
+		{At: now.Add(-30*day + time.Hour), UsedBytes: 4},
+		// recent samples are all kept
+		{At: now.Add(-2*day - time.Hour), UsedBytes: 7},
+		{At: now.Add(-2 * day), UsedBytes: 8},
+	}
+
+	compacted := util.CompactUsageHistory(samples, now, util.DefaultUsageRetention)
+	used := []int64{}
+	for _, s := range compacted {
+		used = append(used, s.UsedBytes)
+	}
+	require.Equal(t, []int64{5, 7, 8, 9}, used)
+}
+
+func TestProjectUsage(t *testing.T) {
+	now := time.Now()
+	day := 24 * time.Hour
+	testCases := []struct {
+		Name      string
+		History   []util.UsageSample
+		Current   int64
+		Projected int64
+		Growth    int64
+	}{
+		{Name: "nohistory", History: nil, Current: 100, Projected: 100, Growth: 0},
+		{
+			Name:      "growing",
+			History:   []util.UsageSample{{At: now.Add(-10 * day), UsedBytes: 0}, {At: now, UsedBytes: 1000}},
+			Current:   1000,
+			Projected: 1000 + 90*100,
+			Growth:    100,
+		},
+		{
+			Name:      "tooshort",
+			History:   []util.UsageSample{{At: now.Add(-2 * day), UsedBytes: 0}, {At: now, UsedBytes: 1000}},
+			Current:   1000,
+			Projected: 1000,
+			Growth:    0,
+		},
+		{
+			Name:      "shrinking",
+			History:   []util.UsageSample{{At: now.Add(-10 * day), UsedBytes: 5000}, {At: now, UsedBytes: 1000}},
+			Current:   1000,
+			Projected: 5000,
+			Growth:    0,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			projected, growth := util.ProjectUsage(tt.History, tt.Current, 90*day)
+			require.Equal(t, tt.Projected, projected)
+			require.Equal(t, tt.Growth, growth)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	PreHookAnnotation  = "down-pvscope.io/pre-hook"
+	PostHookAnnotation = "down-pvscope.io/post-hook"
+)
+
+// ParseHooks decodes a hook annotation which holds either a single Hook or a list of them as proto json
+// e.g. {"name": "bgsave", "command": ["redis-cli", "BGSAVE"], "timeout": "30s"}
+// annotated hooks are held to the same rules as requested ones
+func ParseHooks(value string) ([]*proto.Hook, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	raw := []json.RawMessage{}
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &raw); err != nil {
+			return nil, errors.Wrap(err, "Unable to parse hook list")
+		}
+	} else {
+		raw = append(raw, json.RawMessage(value))
+	}
+
+	problems := []string{}
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	hooks := make([]*proto.Hook, 0, len(raw))
+	for i, r := range raw {
+		hook := &proto.Hook{}
+		if err := protojson.Unmarshal(r, hook); err != nil {
+			return nil, errors.Wrap(err, "Unable to parse hook")
+		}
+		validateHook(fmt.Sprintf("hooks[%d]", i), hook, add)
+		hooks = append(hooks, hook)
+	}
+	if len(problems) > 0 {
+		return nil, errors.New("invalid hooks: " + strings.Join(problems, "; "))
+	}
+	return hooks, nil
+}
+
+// HookName is a human readable name for logs when the hook wasn't explicitly named
+func HookName(hook *proto.Hook) string {
+	if hook.Name != "" {
+		return hook.Name
+	}
+	if hook.Http != nil {
+		return "http " + hook.Http.Path
+	}
+	return strings.Join(hook.Command, " ")
+}
@@ -0,0 +1,43 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHooks(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Value   string
+		Hooks   []string
+		Problem string
+	}{
+		{Name: "empty", Value: "  "},
+		{Name: "single", Value: `{"name": "bgsave", "command": ["redis-cli", "BGSAVE"], "timeout": "30s"}`, Hooks: []string{"bgsave"}},
+		{Name: "list", Value: `[{"command": ["sync"]}, {"http": {"path": "/flush", "port": 8080}}]`, Hooks: []string{"sync", "http /flush"}},
+		{Name: "nothingtorun", Value: `{}`, Problem: "needs a command or http"},
+		{Name: "inlist", Value: `[{"command": ["sync"]}, {"name": "noop"}]`, Problem: "hooks[1]: hook \"noop\" needs a command or http"},
+		{Name: "both", Value: `{"command": ["sync"], "http": {"path": "/flush"}}`, Problem: "can't have both"},
+		{Name: "https", Value: `{"http": {"path": "/flush", "port": 8443, "scheme": "https"}}`, Problem: "scheme \"https\" isn't supported"},
+		{Name: "timeout", Value: `{"command": ["sync"], "timeout": "0s"}`, Problem: "timeout must be positive"},
+		{Name: "malformed", Value: `{"command": "sync"}`, Problem: "Unable to parse hook"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			hooks, err := util.ParseHooks(tt.Value)
+			if tt.Problem != "" {
+				require.ErrorContains(t, err, tt.Problem)
+				return
+			}
+			require.NoError(t, err)
+			names := []string{}
+			for _, hook := range hooks {
+				names = append(names, util.HookName(hook))
+			}
+			require.ElementsMatch(t, tt.Hooks, names)
+		})
+	}
+}
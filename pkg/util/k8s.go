@@ -1,14 +1,27 @@
 package util
 
 import (
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
+func GetRestConfig() (*rest.Config, error) {
+	return rest.InClusterConfig()
+}
+
 func GetClientset() (*kubernetes.Clientset, error) {
-	cfg, err := rest.InClusterConfig()
+	cfg, err := GetRestConfig()
 	if err != nil {
 		return nil, err
 	}
 	return kubernetes.NewForConfig(cfg)
 }
+
+func GetDynamicClient() (*dynamic.DynamicClient, error) {
+	cfg, err := GetRestConfig()
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(cfg)
+}
@@ -1,11 +1,16 @@
 package util
 
 import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
+// SnapshotAPIGroup is the external-snapshotter api group - snapshots are only reachable through the dynamic client
+const SnapshotAPIGroup = "snapshot.storage.k8s.io"
+
 type PvcInfo struct {
 	// Meta fields
 	// NOTE: creating the PVC with existing annotations can cause issues
@@ -22,6 +27,9 @@ type PvcInfo struct {
 	AccessModes      []string `json:"accessModes"`
 	RequestedStorage string   `json:"requestedStorage"`
 	LimitStorage     string   `json:"limitStorage"`
+
+	// DataSourceSnapshot restores a new claim from this VolumeSnapshot
+	DataSourceSnapshot string `json:"dataSourceSnapshot"`
 }
 
 func NewPVCInfo(pvc *corev1.PersistentVolumeClaim) *PvcInfo {
@@ -40,16 +48,23 @@ func NewPVCInfo(pvc *corev1.PersistentVolumeClaim) *PvcInfo {
 		limitStorage = storage.String()
 	}
 
+	dataSourceSnapshot := ""
+	if source := pvc.Spec.DataSource; source != nil && source.Kind == "VolumeSnapshot" {
+		dataSourceSnapshot = source.Name
+	}
+
 	return &PvcInfo{
 		Name:             pvc.Name,
 		Namespace:        pvc.Namespace,
 		Labels:           pvc.Labels,
 		Annotations:      pvc.Annotations,
 		VolumeName:       pvc.Spec.VolumeName,
-		StorageClassName: *pvc.Spec.StorageClassName,
+		StorageClassName: ptr.Deref(pvc.Spec.StorageClassName, ""),
 		AccessModes:      accessModes,
 		RequestedStorage: requestedStorage,
 		LimitStorage:     limitStorage,
+
+		DataSourceSnapshot: dataSourceSnapshot,
 	}
 }
 
@@ -69,6 +84,16 @@ func (pvc *PvcInfo) ToK8s() (*corev1.PersistentVolumeClaim, error) {
 		return nil, err
 	}
 
+	var dataSource *corev1.TypedLocalObjectReference
+	if pvc.DataSourceSnapshot != "" {
+		apiGroup := SnapshotAPIGroup
+		dataSource = &corev1.TypedLocalObjectReference{
+			APIGroup: &apiGroup,
+			Kind:     "VolumeSnapshot",
+			Name:     pvc.DataSourceSnapshot,
+		}
+	}
+
 	return &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        pvc.Name,
@@ -88,6 +113,32 @@ func (pvc *PvcInfo) ToK8s() (*corev1.PersistentVolumeClaim, error) {
 			},
 			StorageClassName: &pvc.StorageClassName,
 			VolumeName:       pvc.VolumeName,
+			DataSource:       dataSource,
 		},
 	}, nil
 }
+
+// NewPVCInfoFromProto turns an exported spec back into a pvc that can be created
+func NewPVCInfoFromProto(spec *proto.PvcSpec) *PvcInfo {
+	return &PvcInfo{
+		Name:             spec.Name,
+		Namespace:        spec.Namespace,
+		Labels:           spec.Labels,
+		StorageClassName: spec.StorageClassName,
+		AccessModes:      spec.AccessModes,
+		RequestedStorage: spec.RequestedStorage,
+		LimitStorage:     spec.RequestedStorage,
+	}
+}
+
+// ToProto keeps only what's portable across clusters - the volume and annotations stay behind
+func (pvc *PvcInfo) ToProto() *proto.PvcSpec {
+	return &proto.PvcSpec{
+		Name:             pvc.Name,
+		Namespace:        pvc.Namespace,
+		Labels:           pvc.Labels,
+		StorageClassName: pvc.StorageClassName,
+		AccessModes:      pvc.AccessModes,
+		RequestedStorage: pvc.RequestedStorage,
+	}
+}
@@ -0,0 +1,121 @@
+package util
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// DefaultHeadroom is the free space kept on top of the used bytes as a fraction of them
+	DefaultHeadroom = 0.25
+	// DefaultMinReclaim is the smallest saving worth a resize
+	DefaultMinReclaim = 1 << 30
+)
+
+// RecommendOptions decide what a scan recommends
+type RecommendOptions struct {
+	Headroom   float64
+	MinReclaim int64
+	Horizon    time.Duration
+}
+
+// ScanLimits fills in the scan's defaults
+func ScanLimits(scan *proto.Scan) (RecommendOptions, error) {
+	options := RecommendOptions{Headroom: scan.Headroom, MinReclaim: DefaultMinReclaim, Horizon: DefaultGrowthHorizon}
+	if options.Headroom == 0 {
+		options.Headroom = DefaultHeadroom
+	}
+	if options.Headroom < 0 {
+		return options, errors.New("headroom must not be negative")
+	}
+
+	if scan.MinReclaim != "" {
+		quantity, err := resource.ParseQuantity(scan.MinReclaim)
+		if err != nil {
+			return options, errors.Wrapf(err, "Unable to parse min_reclaim %q", scan.MinReclaim)
+		}
+		options.MinReclaim = quantity.Value()
+	}
+
+	if scan.GrowthHorizon != nil {
+		if err := scan.GrowthHorizon.CheckValid(); err != nil {
+			return options, errors.Wrap(err, "invalid growth_horizon")
+		}
+		if scan.GrowthHorizon.AsDuration() < 0 {
+			return options, errors.New("growth_horizon must not be negative")
+		}
+		options.Horizon = scan.GrowthHorizon.AsDuration()
+	}
+	return options, nil
+}
+
+// SuggestSize is the smallest whole number of GiB that leaves headroom free on top of used, at least 1Gi
+func SuggestSize(used int64, headroom float64) int64 {
+	need := int64(math.Ceil(float64(used) * (1 + headroom)))
+	gib := (need + 1<<30 - 1) >> 30
+	return max(gib, 1) << 30
+}
+
+// Recommend suggests a new size for a claim from its usage, nil when it isn't worth shrinking
+// with a usage history the size also fits the growth expected within the horizon
+func Recommend(claim PvcInfo, sts string, stats VolumeStats, history []UsageSample, options RecommendOptions) (*proto.Recommendation, error) {
+	current, err := resource.ParseQuantity(claim.RequestedStorage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to parse size of pvc %s", claim.Name)
+	}
+
+	projected, growth := ProjectUsage(history, stats.UsedBytes, options.Horizon)
+	suggested := SuggestSize(projected, options.Headroom)
+	reclaimable := current.Value() - suggested
+	if reclaimable <= 0 || reclaimable < options.MinReclaim {
+		return nil, nil
+	}
+	return &proto.Recommendation{
+		Namespace:         claim.Namespace,
+		Pvc:               claim.Name,
+		Sts:               sts,
+		CurrentSize:       claim.RequestedStorage,
+		UsedBytes:         stats.UsedBytes,
+		CapacityBytes:     stats.CapacityBytes,
+		InodesUsed:        stats.InodesUsed,
+		SuggestedSize:     resource.NewQuantity(suggested, resource.BinarySI).String(),
+		ReclaimableBytes:  reclaimable,
+		ProjectedBytes:    projected,
+		GrowthBytesPerDay: growth,
+		Samples:           int32(len(history)),
+	}, nil
+}
+
+// RankRecommendations sorts the largest savings first
+func RankRecommendations(recommendations []*proto.Recommendation) {
+	slices.SortFunc(recommendations, func(a, b *proto.Recommendation) int {
+		return cmp.Or(
+			cmp.Compare(b.ReclaimableBytes, a.ReclaimableBytes),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Pvc, b.Pvc),
+		)
+	})
+}
+
+// FleetFromScan turns every recommendation a statefulset owns into a fleet target
+func FleetFromScan(report *proto.ScanReport) *proto.Fleet {
+	fleet := &proto.Fleet{}
+	for _, r := range report.Recommendations {
+		if r.Sts == "" {
+			continue
+		}
+		fleet.Targets = append(fleet.Targets, &proto.Scale{
+			Namespace: r.Namespace,
+			Pvc:       r.Pvc,
+			Sts:       r.Sts,
+			Size:      r.SuggestedSize,
+		})
+	}
+	return fleet
+}
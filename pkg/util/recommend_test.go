@@ -0,0 +1,113 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSuggestSize(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Used      int64
+		Headroom  float64
+		Suggested int64
+	}{
+		{Name: "empty", Used: 0, Headroom: 0.25, Suggested: 1 << 30},
+		{Name: "roundup", Used: 3 << 30, Headroom: 0.25, Suggested: 4 << 30},
+		{Name: "exact", Used: 4 << 30, Headroom: 0.25, Suggested: 5 << 30},
+		{Name: "justover", Used: 4<<30 + 1, Headroom: 0.25, Suggested: 6 << 30},
+		{Name: "noheadroom", Used: 2 << 30, Headroom: 0, Suggested: 2 << 30},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			require.Equal(t, tt.Suggested, util.SuggestSize(tt.Used, tt.Headroom))
+		})
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	claim := util.PvcInfo{Name: "data-db-0", Namespace: "foo", RequestedStorage: "100Gi"}
+
+	options := util.RecommendOptions{Headroom: 0.25, MinReclaim: 1 << 30, Horizon: util.DefaultGrowthHorizon}
+
+	recommendation, err := util.Recommend(claim, "db", util.VolumeStats{UsedBytes: 10 << 30, CapacityBytes: 98 << 30}, nil, options)
+	require.NoError(t, err)
+	require.Equal(t, "13Gi", recommendation.SuggestedSize)
+	require.Equal(t, int64(87<<30), recommendation.ReclaimableBytes)
+	require.Equal(t, "db", recommendation.Sts)
+
+	// a volume growing 100Mi a day needs room for 90 more days of it
+	now := time.Now()
+	history := []util.UsageSample{
+		{At: now.Add(-30 * 24 * time.Hour), UsedBytes: 10<<30 - 3000<<20},
+		{At: now.Add(-15 * 24 * time.Hour), UsedBytes: 10<<30 - 1500<<20},
+		{At: now, UsedBytes: 10 << 30},
+	}
+	recommendation, err = util.Recommend(claim, "db", util.VolumeStats{UsedBytes: 10 << 30}, history, options)
+	require.NoError(t, err)
+	require.Equal(t, int64(100<<20), recommendation.GrowthBytesPerDay)
+	require.Equal(t, int64(10<<30+9000<<20), recommendation.ProjectedBytes)
+	require.Equal(t, "24Gi", recommendation.SuggestedSize)
+	require.Equal(t, int32(3), recommendation.Samples)
+
+	// a saving under min_reclaim isn't worth the downtime
+	options.MinReclaim = 2 << 30
+	recommendation, err = util.Recommend(claim, "db", util.VolumeStats{UsedBytes: 79 << 30}, nil, options)
+	require.NoError(t, err)
+	require.Nil(t, recommendation)
+
+	// nearly full volumes are never grown
+	options.MinReclaim = 0
+	recommendation, err = util.Recommend(claim, "db", util.VolumeStats{UsedBytes: 95 << 30}, nil, options)
+	require.NoError(t, err)
+	require.Nil(t, recommendation)
+}
+
+func TestRankRecommendations(t *testing.T) {
+	recommendations := []*proto.Recommendation{
+		{Namespace: "foo", Pvc: "b", ReclaimableBytes: 1},
+		{Namespace: "foo", Pvc: "a", ReclaimableBytes: 1},
+		{Namespace: "bar", Pvc: "z", ReclaimableBytes: 5},
+	}
+	util.RankRecommendations(recommendations)
+	require.Equal(t, "z", recommendations[0].Pvc)
+	require.Equal(t, "a", recommendations[1].Pvc)
+	require.Equal(t, "b", recommendations[2].Pvc)
+}
+
+func TestFleetFromScan(t *testing.T) {
+	fleet := util.FleetFromScan(&proto.ScanReport{Recommendations: []*proto.Recommendation{
+		{Namespace: "foo", Pvc: "data-db-0", Sts: "db", SuggestedSize: "13Gi"},
+		{Namespace: "foo", Pvc: "scratch", SuggestedSize: "1Gi"},
+	}})
+	require.Len(t, fleet.Targets, 1)
+	require.Equal(t, "data-db-0", fleet.Targets[0].Pvc)
+	require.Equal(t, "13Gi", fleet.Targets[0].Size)
+	require.NoError(t, util.ValidateFleet(fleet))
+}
+
+func TestScanLimits(t *testing.T) {
+	options, err := util.ScanLimits(&proto.Scan{})
+	require.NoError(t, err)
+	require.Equal(t, util.DefaultHeadroom, options.Headroom)
+	require.Equal(t, int64(util.DefaultMinReclaim), options.MinReclaim)
+	require.Equal(t, util.DefaultGrowthHorizon, options.Horizon)
+
+	options, err = util.ScanLimits(&proto.Scan{MinReclaim: "5Gi", GrowthHorizon: durationpb.New(30 * 24 * time.Hour)})
+	require.NoError(t, err)
+	require.Equal(t, int64(5<<30), options.MinReclaim)
+	require.Equal(t, 30*24*time.Hour, options.Horizon)
+
+	_, err = util.ScanLimits(&proto.Scan{Headroom: -1})
+	require.Error(t, err)
+	_, err = util.ScanLimits(&proto.Scan{MinReclaim: "lots"})
+	require.Error(t, err)
+	_, err = util.ScanLimits(&proto.Scan{GrowthHorizon: durationpb.New(-time.Hour)})
+	require.Error(t, err)
+}
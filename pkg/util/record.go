@@ -0,0 +1,152 @@
+package util
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RecordQuery returns the ResizeRecord of a resize workflow
+const RecordQuery = "record"
+
+// OriginalPVSignal cuts the retention period of the original pv short
+// the payload is either OriginalPVDelete or OriginalPVKeep
+const OriginalPVSignal = "original-pv"
+
+const (
+	// OriginalPVDelete deletes the original pv now
+	OriginalPVDelete = "delete"
+	// OriginalPVKeep keeps the original pv indefinitely and ends the workflow
+	OriginalPVKeep = "keep"
+)
+
+// StatusQuery returns the ScaleStatus proto of a resize workflow
+const StatusQuery = "status"
+
+// PlanQuery returns the ResizePlan a resize workflow is waiting to have approved
+const PlanQuery = "plan"
+
+// ApprovalUpdate approves or rejects a pending ResizePlan with an ApprovalDecision
+// it can be sent as a workflow update (which reports whether it was accepted) or a signal
+const ApprovalUpdate = "approval"
+
+const (
+	// PauseSignal parks a resize at its next breakpoint, ResumeSignal lets it continue
+	PauseSignal  = "pause"
+	ResumeSignal = "resume"
+	// ParkedQuery returns where a resize is parked as a *Parked (nil while it's running)
+	ParkedQuery = "parked"
+)
+
+// Breakpoints a resize can park at, each one comes right before a destructive step
+const (
+	BeforeScaleDown      = "before-scale-down"
+	BeforeDeleteOriginal = "before-delete-original"
+	BeforeRebind         = "before-rebind"
+	BeforeScaleUp        = "before-scale-up"
+)
+
+// Breakpoints lists every breakpoint in the order a resize reaches them
+var Breakpoints = []string{BeforeScaleDown, BeforeDeleteOriginal, BeforeRebind, BeforeScaleUp}
+
+// Parked is where a paused resize is waiting
+type Parked struct {
+	Breakpoint string    `json:"breakpoint"`
+	Since      time.Time `json:"since"`
+	// Reason is either the requested breakpoint or a pause signal
+	Reason string `json:"reason"`
+}
+
+const (
+	// LastResizeAnnotation holds the ScaleResult of the last resize of a pvc as proto json
+	LastResizeAnnotation = "down-pvscope.io/last-resize"
+	// ResizedPVCLabel marks the ConfigMaps holding ScaleResults with the pvc they resized
+	ResizedPVCLabel = "down-pvscope.io/resized-pvc"
+	// ResultKey is the ConfigMap key holding the ScaleResult
+	ResultKey = "result.json"
+)
+
+const (
+	// ClonedFromNamespaceLabel and ClonedFromPVCLabel record where a cloned pvc's data came from
+	ClonedFromNamespaceLabel = "down-pvscope.io/cloned-from-namespace"
+	ClonedFromPVCLabel       = "down-pvscope.io/cloned-from-pvc"
+
+	// SeededByAnnotation marks replica claims pre-created by a seed workflow with its workflow id
+	SeededByAnnotation = "down-pvscope.io/seeded-by"
+	// ImportedByAnnotation marks claims created by an import workflow with its workflow id
+	ImportedByAnnotation = "down-pvscope.io/imported-by"
+)
+
+// ResizeRecord is what a resize workflow remembers about the claim it moved
+// it holds everything needed to put the original volume back later
+type ResizeRecord struct {
+	Namespace   string `json:"namespace"`
+	StatefulSet string `json:"statefulSet"`
+
+	// OriginalPVC is the claim as it was before the resize, VolumeName is the original pv
+	OriginalPVC           PvcInfo                              `json:"originalPVC"`
+	OriginalReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"originalReclaimPolicy"`
+
+	NewPV   string `json:"newPV"`
+	NewSize string `json:"newSize"`
+
+	InitialReplicas int32 `json:"initialReplicas"`
+
+	// EstimatedDowntime is the sampled prediction (only made with a max_downtime or maintenance window)
+	// Downtime is how long the sts actually spent at 0 replicas
+	EstimatedDowntime time.Duration `json:"estimatedDowntime"`
+	Downtime          time.Duration `json:"downtime"`
+
+	// Snapshot is the VolumeSnapshot of the original pvc (if one was taken)
+	Snapshot        string `json:"snapshot"`
+	SnapshotDeleted bool   `json:"snapshotDeleted"`
+
+	// Backup is the off-cluster copy of the original pvc (if one was made)
+	Backup *BackupInfo `json:"backup"`
+	// CopyTimeout is how long the resize let its copy jobs run, restoring its backup gets as long
+	CopyTimeout time.Duration `json:"copyTimeout"`
+
+	// Approver and ApprovalReason come from the decision that let the resize continue (if one was required)
+	Approver       string `json:"approver"`
+	ApprovalReason string `json:"approvalReason"`
+
+	// Completed is set once the claim is bound to the new pv and verified
+	Completed         bool `json:"completed"`
+	OriginalPVDeleted bool `json:"originalPVDeleted"`
+
+	// OriginalPVKept is set once a keep signal settled that the original pv stays, OriginalPVDeleting as soon
+	// as its deletion starts - a rollback waits for one of them (or the end of the resize) before trusting the record
+	OriginalPVKept     bool `json:"originalPVKept"`
+	OriginalPVDeleting bool `json:"originalPVDeleting"`
+}
+
+// ResizePlan is what a resize workflow is about to do once the sts is scaled to 0
+type ResizePlan struct {
+	Namespace   string `json:"namespace"`
+	StatefulSet string `json:"statefulSet"`
+	Replicas    int32  `json:"replicas"`
+
+	PVC         string `json:"pvc"`
+	OriginalPV  string `json:"originalPV"`
+	CurrentSize string `json:"currentSize"`
+	NewSize     string `json:"newSize"`
+	// StagingPVC already holds the new pv
+	StagingPVC string `json:"stagingPVC"`
+	NewPV      string `json:"newPV"`
+
+	EstimatedDowntime time.Duration `json:"estimatedDowntime"`
+	PreQuiesceHooks   []string      `json:"preQuiesceHooks"`
+	PostResumeHooks   []string      `json:"postResumeHooks"`
+}
+
+// BackupInfo locates a copy of a volume in an S3-compatible bucket
+type BackupInfo struct {
+	// SecretName holds the remote's credentials (access_key_id, secret_access_key, endpoint, region, provider)
+	SecretName string `json:"secretName"`
+	Bucket     string `json:"bucket"`
+	// Prefix holds the data under <prefix>/data and the md5 manifest at <prefix>/MD5SUMS
+	Prefix string `json:"prefix"`
+
+	Manifest       string `json:"manifest"`
+	ManifestSHA256 string `json:"manifestSHA256"`
+}
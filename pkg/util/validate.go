@@ -0,0 +1,128 @@
+package util
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidateScaleRequest catches everything about a request that can be checked without looking at the cluster
+// every problem is reported at once
+func ValidateScaleRequest(req *protov2.ScaleRequest) error {
+	problems := []string{}
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+	// namespaces are dns labels, the objects in them dns subdomains
+	name := func(field, value string, valid func(string) []string) {
+		if value == "" {
+			add("%s is required", field)
+			return
+		}
+		for _, msg := range valid(value) {
+			add("%s %q: %s", field, value, msg)
+		}
+	}
+	duration := func(field string, d *durationpb.Duration) {
+		if d == nil {
+			return
+		}
+		if err := d.CheckValid(); err != nil {
+			add("%s: %s", field, err)
+		} else if d.AsDuration() < 0 {
+			add("%s must not be negative", field)
+		}
+	}
+
+	name("workload.namespace", req.GetWorkload().GetNamespace(), validation.IsDNS1123Label)
+	name("workload.statefulset", req.GetWorkload().GetStatefulset(), validation.IsDNS1123Subdomain)
+	name("target.pvc", req.GetTarget().GetPvc(), validation.IsDNS1123Subdomain)
+
+	if size := req.GetTarget().GetSize(); size == "" {
+		add("target.size is required")
+	} else if quantity, err := resource.ParseQuantity(size); err != nil {
+		add("target.size %q: %s", size, err)
+	} else if quantity.Sign() <= 0 {
+		add("target.size %q must be positive", size)
+	}
+
+	safety := req.GetOptions().GetSafety()
+	duration("options.safety.max_downtime", safety.GetMaxDowntime())
+	duration("options.safety.approval.timeout", safety.GetApproval().GetTimeout())
+	for _, bp := range safety.GetBreakpoints() {
+		if !slices.Contains(Breakpoints, bp) {
+			add("options.safety.breakpoints: unknown breakpoint %q", bp)
+		}
+	}
+	if window := safety.GetMaintenanceWindow(); window != nil {
+		if _, _, err := NextWindow(window, time.Time{}); err != nil {
+			add("options.safety.maintenance_window: %s", err)
+		}
+	}
+
+	hooks := req.GetOptions().GetHooks()
+	for field, list := range map[string][]*proto.Hook{"options.hooks.pre_quiesce": hooks.GetPreQuiesce(), "options.hooks.post_resume": hooks.GetPostResume()} {
+		for _, hook := range list {
+			validateHook(field, hook, add)
+		}
+	}
+	if probe := req.GetOptions().GetVerification().GetProbe(); probe != nil {
+		validateHook("options.verification.probe", probe, add)
+	}
+	duration("options.verification.stable_for", req.GetOptions().GetVerification().GetStableFor())
+
+	retention := req.GetOptions().GetRetention()
+	duration("options.retention.retain_original_for", retention.GetRetainOriginalFor())
+	if backup := retention.GetBackup(); backup.GetEnabled() && (backup.GetSecretName() == "" || backup.GetBucket() == "") {
+		add("options.retention.backup needs both a secret_name and a bucket")
+	}
+
+	duration("options.timeouts.copy", req.GetOptions().GetTimeouts().GetCopy())
+	duration("options.timeouts.hook", req.GetOptions().GetTimeouts().GetHook())
+
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return errors.New("invalid scale request: " + strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func validateHook(field string, hook *proto.Hook, add func(string, ...any)) {
+	name := HookName(hook)
+	if len(hook.Command) == 0 && hook.Http == nil {
+		add("%s: hook %q needs a command or http", field, name)
+	}
+	if len(hook.Command) > 0 && hook.Http != nil {
+		add("%s: hook %q can't have both a command and http", field, name)
+	}
+	if hook.Timeout != nil && hook.Timeout.AsDuration() <= 0 {
+		add("%s: hook %q timeout must be positive", field, name)
+	}
+	if scheme := hook.GetHttp().GetScheme(); scheme != "" && scheme != "http" {
+		add("%s: hook %q scheme %q isn't supported, http hooks call the pod ip which has no certificate to verify", field, name, scheme)
+	}
+}
+
+// CheckShrink makes sure the requested size is actually smaller than the pvc's current size
+func CheckShrink(current, requested string) error {
+	currentSize, err := resource.ParseQuantity(current)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to parse current size %q", current)
+	}
+	requestedSize, err := resource.ParseQuantity(requested)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to parse requested size %q", requested)
+	}
+	if requestedSize.Cmp(currentSize) >= 0 {
+		return errors.Errorf("requested size %s is not smaller than the current size %s", requested, current)
+	}
+	return nil
+}
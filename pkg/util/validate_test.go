@@ -0,0 +1,77 @@
+package util_test
+
+import (
+	"testing"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateScaleRequest(t *testing.T) {
+	valid := func() *protov2.ScaleRequest {
+		return util.ScaleRequestFromV1(&proto.Scale{Namespace: "foo", Pvc: "data-db-0", Size: "10Gi", Sts: "db"})
+	}
+
+	testCases := []struct {
+		Name    string
+		Modify  func(req *protov2.ScaleRequest)
+		Problem string
+	}{
+		{Name: "valid", Modify: func(req *protov2.ScaleRequest) {}},
+		{Name: "nosts", Modify: func(req *protov2.ScaleRequest) { req.Workload.Statefulset = "" }, Problem: "workload.statefulset is required"},
+		{Name: "dottednamespace", Modify: func(req *protov2.ScaleRequest) { req.Workload.Namespace = "a.b" }, Problem: "workload.namespace \"a.b\""},
+		{Name: "dottedpvc", Modify: func(req *protov2.ScaleRequest) { req.Target.Pvc = "data.db-0" }},
+		{Name: "badname", Modify: func(req *protov2.ScaleRequest) { req.Target.Pvc = "Data_0" }, Problem: "target.pvc"},
+		{Name: "badsize", Modify: func(req *protov2.ScaleRequest) { req.Target.Size = "10gb" }, Problem: "target.size \"10gb\""},
+		{Name: "zerosize", Modify: func(req *protov2.ScaleRequest) { req.Target.Size = "0" }, Problem: "must be positive"},
+		{
+			Name:    "breakpoint",
+			Modify:  func(req *protov2.ScaleRequest) { req.Options.Safety.Breakpoints = []string{"before-lunch"} },
+			Problem: "unknown breakpoint",
+		},
+		{
+			Name: "hook",
+			Modify: func(req *protov2.ScaleRequest) {
+				req.Options.Hooks.PreQuiesce = []*proto.Hook{{Name: "empty"}}
+			},
+			Problem: "needs a command or http",
+		},
+		{
+			Name: "negative",
+			Modify: func(req *protov2.ScaleRequest) {
+				req.Options.Retention.RetainOriginalFor = durationpb.New(-1)
+			},
+			Problem: "must not be negative",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			req := valid()
+			tt.Modify(req)
+			err := util.ValidateScaleRequest(req)
+			if tt.Problem == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.Problem)
+		})
+	}
+}
+
+func TestValidateScaleRequestReportsEverything(t *testing.T) {
+	err := util.ValidateScaleRequest(&protov2.ScaleRequest{})
+	require.ErrorContains(t, err, "workload.namespace is required")
+	require.ErrorContains(t, err, "workload.statefulset is required")
+	require.ErrorContains(t, err, "target.pvc is required")
+	require.ErrorContains(t, err, "target.size is required")
+}
+
+func TestCheckShrink(t *testing.T) {
+	require.NoError(t, util.CheckShrink("20Gi", "10Gi"))
+	require.Error(t, util.CheckShrink("10Gi", "10Gi"))
+	require.Error(t, util.CheckShrink("10Gi", "20G"))
+}
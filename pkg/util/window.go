@@ -0,0 +1,84 @@
+package util
+
+import (
+	"strings"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	MaintenanceWindowAnnotation = "down-pvscope.io/maintenance-window"
+	TimezoneAnnotation          = "down-pvscope.io/timezone"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseMaintenanceWindow decodes a window annotation as proto json
+// e.g. {"days": ["mon", "tue", "wed", "thu", "fri"], "start": "01:00", "end": "04:00"}
+func ParseMaintenanceWindow(value string) (*proto.MaintenanceWindow, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	window := &proto.MaintenanceWindow{}
+	if err := protojson.Unmarshal([]byte(value), window); err != nil {
+		return nil, errors.Wrap(err, "Unable to parse maintenance window")
+	}
+	return window, nil
+}
+
+// NextWindow finds the window that is open at `now` or, failing that, the next one to open
+func NextWindow(window *proto.MaintenanceWindow, now time.Time) (time.Time, time.Time, error) {
+	loc, err := time.LoadLocation(window.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Wrapf(err, "Unknown timezone %q", window.Timezone)
+	}
+
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Wrapf(err, "Invalid window start %q", window.Start)
+	}
+	end, err := time.Parse("15:04", window.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.Wrapf(err, "Invalid window end %q", window.End)
+	}
+	length := end.Sub(start)
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+
+	days := map[time.Weekday]bool{}
+	for _, day := range window.Days {
+		weekday, ok := weekdays[strings.ToLower(day)[:min(3, len(day))]]
+		if !ok {
+			return time.Time{}, time.Time{}, errors.Errorf("Invalid window day %q", day)
+		}
+		days[weekday] = true
+	}
+
+	local := now.In(loc)
+	// start a day early in case yesterday's window runs past midnight
+	for offset := -1; offset <= 7; offset++ {
+		opens := time.Date(local.Year(), local.Month(), local.Day()+offset, start.Hour(), start.Minute(), 0, 0, loc)
+		if len(days) > 0 && !days[opens.Weekday()] {
+			continue
+		}
+		closes := opens.Add(length)
+		if closes.After(now) {
+			return opens, closes, nil
+		}
+	}
+	return time.Time{}, time.Time{}, errors.New("Maintenance window never opens")
+}
@@ -0,0 +1,84 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextWindow(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	require.NoError(t, err)
+	weeknights := &proto.MaintenanceWindow{
+		Days:     []string{"mon", "tue", "wed", "thu", "fri"},
+		Start:    "01:00",
+		End:      "04:00",
+		Timezone: "America/Toronto",
+	}
+	overnight := &proto.MaintenanceWindow{Start: "23:00", End: "02:00"}
+
+	testCases := []struct {
+		Name   string
+		Window *proto.MaintenanceWindow
+		Now    time.Time
+		Start  time.Time
+		End    time.Time
+	}{
+		{
+			Name:   "before",
+			Window: weeknights,
+			// a tuesday
+			Now:   time.Date(2026, 10, 20, 0, 30, 0, 0, toronto),
+			Start: time.Date(2026, 10, 20, 1, 0, 0, 0, toronto),
+			End:   time.Date(2026, 10, 20, 4, 0, 0, 0, toronto),
+		},
+		{
+			Name:   "inside",
+			Window: weeknights,
+			Now:    time.Date(2026, 10, 20, 2, 0, 0, 0, toronto),
+			Start:  time.Date(2026, 10, 20, 1, 0, 0, 0, toronto),
+			End:    time.Date(2026, 10, 20, 4, 0, 0, 0, toronto),
+		},
+		{
+			Name:   "weekend",
+			Window: weeknights,
+			Now:    time.Date(2026, 10, 23, 5, 0, 0, 0, toronto),
+			Start:  time.Date(2026, 10, 26, 1, 0, 0, 0, toronto),
+			End:    time.Date(2026, 10, 26, 4, 0, 0, 0, toronto),
+		},
+		{
+			Name:   "utc",
+			Window: weeknights,
+			Now:    time.Date(2026, 10, 20, 4, 0, 0, 0, time.UTC),
+			Start:  time.Date(2026, 10, 20, 1, 0, 0, 0, toronto),
+			End:    time.Date(2026, 10, 20, 4, 0, 0, 0, toronto),
+		},
+		{
+			Name:   "pastmidnight",
+			Window: overnight,
+			Now:    time.Date(2026, 10, 20, 1, 0, 0, 0, time.UTC),
+			Start:  time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC),
+			End:    time.Date(2026, 10, 20, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			start, end, err := util.NextWindow(tt.Window, tt.Now)
+			require.NoError(t, err)
+			require.True(t, tt.Start.Equal(start), "start %s", start)
+			require.True(t, tt.End.Equal(end), "end %s", end)
+		})
+	}
+}
+
+func TestNextWindowInvalid(t *testing.T) {
+	_, _, err := util.NextWindow(&proto.MaintenanceWindow{Start: "1am", End: "04:00"}, time.Now())
+	require.Error(t, err)
+
+	_, _, err = util.NextWindow(&proto.MaintenanceWindow{Days: []string{"someday"}, Start: "01:00", End: "04:00"}, time.Now())
+	require.Error(t, err)
+}
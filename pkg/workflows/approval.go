@@ -0,0 +1,83 @@
+package workflows
+
+import (
+	"fmt"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/workflow"
+)
+
+// awaitApproval publishes the plan and blocks until someone approves or rejects it
+// nobody deciding before the timeout counts as a rejection
+func awaitApproval(ctx workflow.Context, audit *auditLog, plan *util.ResizePlan, approval *proto.Approval) (*proto.ApprovalDecision, error) {
+	logger := workflow.GetLogger(ctx)
+
+	err := workflow.SetQueryHandler(ctx, util.PlanQuery, func() (*util.ResizePlan, error) {
+		return plan, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var decision *proto.ApprovalDecision
+	validate := func(d *proto.ApprovalDecision) error {
+		if decision != nil {
+			return errors.New("plan was already decided")
+		}
+		if d.GetApprover() == "" {
+			return errors.New("approver is required")
+		}
+		return nil
+	}
+	err = workflow.SetUpdateHandlerWithOptions(ctx, util.ApprovalUpdate,
+		func(ctx workflow.Context, d *proto.ApprovalDecision) error {
+			decision = d
+			return nil
+		},
+		workflow.UpdateHandlerOptions{Validator: validate},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// signals can't be refused so an invalid one is only logged
+	signals := workflow.GetSignalChannel(ctx, util.ApprovalUpdate)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var d *proto.ApprovalDecision
+			signals.Receive(ctx, &d)
+			if err := validate(d); err != nil {
+				logger.Warn("Ignoring approval signal", "error", err)
+				continue
+			}
+			decision = d
+		}
+	})
+
+	logger.Info("Waiting for approval", "pvc", plan.PVC, "newSize", plan.NewSize)
+	audit.record(ctx, "approval-requested", fmt.Sprintf("resize %s to %s with %s at 0 replicas", plan.PVC, plan.NewSize, plan.EstimatedDowntime))
+	decided := func() bool { return decision != nil }
+	if timeout := approval.Timeout.AsDuration(); timeout > 0 {
+		ok, err := workflow.AwaitWithTimeout(ctx, timeout, decided)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			decision = &proto.ApprovalDecision{Approver: "timeout", Reason: fmt.Sprintf("nobody decided within %s", timeout)}
+		}
+	} else {
+		err = workflow.Await(ctx, decided)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	event := "rejected"
+	if decision.Approved {
+		event = "approved"
+	}
+	audit.record(ctx, event, fmt.Sprintf("by %s: %s", decision.Approver, decision.Reason))
+	return decision, nil
+}
@@ -0,0 +1,51 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// AuditQuery returns every AuditEntry recorded by a running (or completed) workflow
+const AuditQuery = "audit"
+
+// AuditEntry records a decision made during the workflow that a human should be able to find later
+// e.g. an operator overriding a safety check
+type AuditEntry struct {
+	Time   time.Time `json:"time"`
+	Event  string    `json:"event"`
+	Detail string    `json:"detail"`
+}
+
+type auditLog struct {
+	entries []AuditEntry
+	// warnings are the entries worth surfacing in a workflow's result
+	warnings []string
+}
+
+// newAuditLog creates an empty audit log and exposes it through the AuditQuery handler
+func newAuditLog(ctx workflow.Context) (*auditLog, error) {
+	a := &auditLog{entries: []AuditEntry{}}
+	err := workflow.SetQueryHandler(ctx, AuditQuery, func() ([]AuditEntry, error) {
+		return a.entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *auditLog) record(ctx workflow.Context, event, detail string) {
+	workflow.GetLogger(ctx).Warn("Audit", "event", event, "detail", detail)
+	a.entries = append(a.entries, AuditEntry{
+		Time:   workflow.Now(ctx),
+		Event:  event,
+		Detail: detail,
+	})
+}
+
+// warn records an entry that also ends up in the workflow's result
+func (a *auditLog) warn(ctx workflow.Context, event, detail string) {
+	a.record(ctx, event, detail)
+	a.warnings = append(a.warnings, event+": "+detail)
+}
@@ -0,0 +1,50 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// backupInfo resolves where a backup of the pvc goes - without a prefix every run gets its own
+func backupInfo(ctx workflow.Context, ns, pvc string, spec *proto.Backup) (util.BackupInfo, error) {
+	if spec.SecretName == "" || spec.Bucket == "" {
+		return util.BackupInfo{}, temporal.NewNonRetryableApplicationError("backup needs both a secret_name and a bucket", "InvalidBackup", nil)
+	}
+
+	prefix := spec.Prefix
+	if prefix == "" {
+		prefix = fmt.Sprintf("%s/%s/%s", ns, pvc, workflow.GetInfo(ctx).WorkflowExecution.RunID)
+	}
+	return util.BackupInfo{
+		SecretName: spec.SecretName,
+		Bucket:     spec.Bucket,
+		Prefix:     prefix,
+	}, nil
+}
+
+// backupPVC copies the pvc off cluster in a job allowed to run for `timeout`
+func backupPVC(ctx workflow.Context, pvc util.PvcInfo, backup util.BackupInfo, timeout time.Duration) (util.BackupInfo, error) {
+	var ja *activities.JobActivities
+	workflow.GetLogger(ctx).Info("Backing up PVC", "pvc", pvc.Name, "bucket", backup.Bucket, "prefix", backup.Prefix)
+	err := workflow.ExecuteActivity(withJobTimeout(ctx, timeout), ja.BackupToRemote, pvc, backup).Get(ctx, &backup)
+	return backup, err
+}
+
+// restoreBackup copies the backup into the pvc in a job allowed to run for `timeout`
+func restoreBackup(ctx workflow.Context, pvc util.PvcInfo, backup util.BackupInfo, timeout time.Duration) error {
+	var ja *activities.JobActivities
+	workflow.GetLogger(ctx).Info("Restoring backup", "pvc", pvc.Name, "bucket", backup.Bucket, "prefix", backup.Prefix)
+	return workflow.ExecuteActivity(withJobTimeout(ctx, timeout), ja.RestoreFromRemote, pvc, backup).Get(ctx, nil)
+}
+
+func deleteBackup(ctx workflow.Context, ns string, backup util.BackupInfo) error {
+	var ja *activities.JobActivities
+	workflow.GetLogger(ctx).Info("Deleting backup", "bucket", backup.Bucket, "prefix", backup.Prefix)
+	return workflow.ExecuteActivity(withJobOptions(ctx), ja.DeleteRemote, ns, backup).Get(ctx, nil)
+}
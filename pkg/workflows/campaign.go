@@ -0,0 +1,78 @@
+package workflows
+
+import (
+	"fmt"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CampaignWorkflow scans for pvcs worth shrinking and either reports them or opens a resize for each
+// resizes wait for approval and outlive the campaign, so a schedule's next run is never held up by them
+// nolint: funlen
+func CampaignWorkflow(ctx workflow.Context, input *proto.Campaign) (*proto.CampaignReport, error) {
+	logger := workflow.GetLogger(ctx)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	if err := util.ValidateCampaign(input); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidCampaign", err)
+	}
+
+	scanReport, err := runScan(ctx, input.Scan)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := util.SelectForCampaign(scanReport.Recommendations, input.MaxUsage)
+	if err != nil {
+		return nil, err
+	}
+	report := &proto.CampaignReport{Scan: scanReport, Selected: selected}
+	logger.Info("Selected pvcs", "recommendations", len(scanReport.Recommendations), "selected", len(selected), "action", input.Action)
+
+	if input.Action == proto.CampaignAction_CAMPAIGN_ACTION_RESIZE {
+		for _, r := range selected {
+			if r.Sts == "" {
+				report.Skipped = append(report.Skipped, fmt.Sprintf("%s/%s: no statefulset owns it", r.Namespace, r.Pvc))
+				continue
+			}
+
+			id := util.ResizeWorkflowID(r.Namespace, r.Pvc)
+			childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+				WorkflowID:            id,
+				WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+				ParentClosePolicy:     enumspb.PARENT_CLOSE_POLICY_ABANDON,
+			})
+			child := workflow.ExecuteChildWorkflow(childCtx, ScaleDownWorkflow, &proto.Scale{
+				Namespace: r.Namespace,
+				Pvc:       r.Pvc,
+				Sts:       r.Sts,
+				Size:      r.SuggestedSize,
+				Approval:  &proto.Approval{Required: true, Timeout: input.ApprovalTimeout},
+			})
+			// only wait for the resize to start, it then waits for its approval on its own
+			if err := child.GetChildWorkflowExecution().Get(ctx, nil); err != nil {
+				// usually a resize of the pvc is still running from an earlier campaign
+				logger.Warn("Unable to start resize", "workflowID", id, "error", err)
+				report.Skipped = append(report.Skipped, fmt.Sprintf("%s/%s: %s", r.Namespace, r.Pvc, err))
+				continue
+			}
+			report.Started = append(report.Started, id)
+		}
+	}
+
+	report.FinishedAt = timestamppb.New(workflow.Now(ctx))
+	if input.ReportConfigmap != "" {
+		var ra *activities.ResultActivities
+		err = workflow.ExecuteActivity(ctx, ra.PublishCampaignReport, input.ReportNamespace, input.ReportConfigmap, report).Get(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("Campaign done", "started", len(report.Started), "skipped", len(report.Skipped))
+	return report, nil
+}
@@ -0,0 +1,79 @@
+package workflows_test
+
+import (
+	"testing"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestCampaignWorkflow(t *testing.T) {
+	scan := &proto.ScanReport{
+		Scanned: 3,
+		Recommendations: []*proto.Recommendation{
+			{Namespace: "db", Pvc: "data-db-0", Sts: "db", CurrentSize: "100Gi", UsedBytes: 10 << 30, SuggestedSize: "13Gi"},
+			{Namespace: "db", Pvc: "scratch", CurrentSize: "100Gi", UsedBytes: 10 << 30, SuggestedSize: "13Gi"},
+			{Namespace: "queue", Pvc: "data-queue-0", Sts: "queue", CurrentSize: "100Gi", UsedBytes: 60 << 30, SuggestedSize: "75Gi"},
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Action   proto.CampaignAction
+		Selected int
+		Started  []string
+		Skipped  int
+	}{
+		{Name: "report", Action: proto.CampaignAction_CAMPAIGN_ACTION_REPORT, Selected: 2},
+		{Name: "resize", Action: proto.CampaignAction_CAMPAIGN_ACTION_RESIZE, Selected: 2, Started: []string{"pvscope/db/data-db-0"}, Skipped: 1},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var s testsuite.WorkflowTestSuite
+			env := s.NewTestWorkflowEnvironment()
+			env.RegisterWorkflow(workflows.ScaleDownWorkflow)
+
+			var sa *activities.ScanActivities
+			var ra *activities.ResultActivities
+			env.OnActivity(sa.ScanNamespaces, mock.Anything, mock.Anything).Return([]string{"db", "queue"}, nil)
+			env.OnActivity(sa.Scan, mock.Anything, mock.Anything, []string{"db", "queue"}).Return(scan, nil)
+			env.OnActivity(ra.PublishCampaignReport, mock.Anything, "down-pvscope", "weekly", mock.Anything).Return(nil)
+			var resizes []*proto.Scale
+			env.OnWorkflow(workflows.ScaleDownWorkflow, mock.Anything, mock.Anything).Return(
+				func(ctx workflow.Context, input *proto.Scale) (*proto.ScaleResult, error) {
+					resizes = append(resizes, input)
+					return &proto.ScaleResult{}, nil
+				})
+
+			env.ExecuteWorkflow(workflows.CampaignWorkflow, &proto.Campaign{
+				Scan:            &proto.Scan{Namespaces: []string{"db", "queue"}},
+				MaxUsage:        0.5,
+				Action:          tt.Action,
+				ReportNamespace: "down-pvscope",
+				ReportConfigmap: "weekly",
+			})
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			report := &proto.CampaignReport{}
+			require.NoError(t, env.GetWorkflowResult(&report))
+			require.Len(t, report.Selected, tt.Selected)
+			require.Equal(t, tt.Started, report.Started)
+			require.Len(t, report.Skipped, tt.Skipped)
+			env.AssertActivityCalled(t, "PublishCampaignReport", mock.Anything, "down-pvscope", "weekly", mock.Anything)
+
+			require.Len(t, resizes, len(tt.Started))
+			for _, resize := range resizes {
+				// every resize the campaign opens waits for someone to approve it
+				require.True(t, resize.Approval.GetRequired())
+				require.Equal(t, "13Gi", resize.Size)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package workflows
+
+import (
+	"fmt"
+	"maps"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CloneWorkflow copies a pvc into a new, labeled claim (possibly in another namespace) without touching the original
+// nolint: funlen
+func CloneWorkflow(ctx workflow.Context, input *proto.Clone) (*proto.PvcSpec, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting clone", "namespace", input.Namespace, "pvc", input.Pvc, "targetNamespace", input.TargetNamespace, "targetName", input.TargetName, "source", input.Source)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	audit, err := newAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.TargetName == "" {
+		return nil, temporal.NewNonRetryableApplicationError("clone needs a target_name", "InvalidClone", nil)
+	}
+	if input.Source == proto.CloneSource_CLONE_SOURCE_QUIESCE && input.Sts == "" {
+		return nil, temporal.NewNonRetryableApplicationError("quiescing a clone source needs its sts", "InvalidClone", nil)
+	}
+
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var ja *activities.JobActivities
+
+	logger.Info("Getting the source PVC", "pvc", input.Pvc, "namespace", input.Namespace)
+	sourcePVC := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.GetPVC, input.Namespace, input.Pvc).Get(ctx, &sourcePVC)
+	if err != nil {
+		return nil, err
+	}
+
+	// the target must never point at the source volume - RebindPV would unlink it
+	target := sourcePVC
+	target.VolumeName = ""
+	target.Name = input.TargetName
+	target.Namespace = input.TargetNamespace
+	if target.Namespace == "" {
+		target.Namespace = input.Namespace
+	}
+	if input.StorageClassName != "" {
+		target.StorageClassName = input.StorageClassName
+	}
+	size := sourcePVC.RequestedStorage
+	if input.Size != "" {
+		size = input.Size
+	}
+	target.Labels = maps.Clone(sourcePVC.Labels)
+	if target.Labels == nil {
+		target.Labels = map[string]string{}
+	}
+	target.Labels[util.ClonedFromNamespaceLabel] = sourcePVC.Namespace
+	target.Labels[util.ClonedFromPVCLabel] = sourcePVC.Name
+
+	// the copy lands next to the source (a job can't mount claims from two namespaces) and is moved afterwards
+	staging := target
+	staging.Namespace = sourcePVC.Namespace
+	logger.Info("Provisioning clone PVC", "size", size, "storageClass", staging.StorageClassName)
+	stagingPVC := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.CreateStagingPVC, staging, size).Get(ctx, &stagingPVC)
+	if err != nil {
+		return nil, err
+	}
+
+	var stagingPolicy corev1.PersistentVolumeReclaimPolicy
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, stagingPVC.VolumeName).Get(ctx, &stagingPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Source {
+	case proto.CloneSource_CLONE_SOURCE_SNAPSHOT:
+		err = copyFromSnapshot(ctx, sourcePVC, stagingPVC, input.VolumeSnapshotClass, activities.JobTimeout)
+	case proto.CloneSource_CLONE_SOURCE_QUIESCE:
+		err = quiesced(ctx, audit, input.Namespace, input.Sts, input.PreQuiesceHooks, input.PostResumeHooks, func() error {
+			return workflow.ExecuteActivity(withJobOptions(ctx), ja.Runrclone, sourcePVC, stagingPVC, input.Namespace).Get(ctx, nil)
+		})
+	case proto.CloneSource_CLONE_SOURCE_LIVE:
+		err = workflow.ExecuteActivity(withJobOptions(ctx), ja.Runrclone, sourcePVC, stagingPVC, input.Namespace).Get(ctx, nil)
+	default:
+		err = temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown clone source %s", input.Source), "InvalidClone", nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Dropping pvc", "pvc", stagingPVC.Name)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, stagingPVC.Namespace, stagingPVC.Name).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Binding clone PV to its claim", "pv", stagingPVC.VolumeName, "namespace", target.Namespace, "pvc", target.Name)
+	err = workflow.ExecuteActivity(ctx, pvca.RebindPV, target.Namespace, stagingPVC.VolumeName, target, size).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, stagingPVC.VolumeName, stagingPolicy).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Clone done", "namespace", target.Namespace, "pvc", target.Name, "pv", stagingPVC.VolumeName)
+	target.VolumeName = stagingPVC.VolumeName
+	target.RequestedStorage = size
+	return target.ToProto(), nil
+}
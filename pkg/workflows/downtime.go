@@ -0,0 +1,141 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// estimateDowntime samples the live pvc and predicts how long the sts will sit at 0 replicas
+// it refuses to go further when the prediction doesn't fit in the budget (if there is one)
+func estimateDowntime(ctx workflow.Context, audit *auditLog, pvc util.PvcInfo, budget time.Duration, precopy bool) (time.Duration, error) {
+	logger := workflow.GetLogger(ctx)
+	var ja *activities.JobActivities
+
+	logger.Info("Sampling source to estimate downtime", "pvc", pvc.Name)
+	sample := util.CopySample{}
+	err := workflow.ExecuteActivity(withJobOptions(ctx), ja.SampleSource, pvc).Get(ctx, &sample)
+	if err != nil {
+		return 0, err
+	}
+
+	estimate := util.EstimateDowntime(sample, precopy)
+	detail := fmt.Sprintf("%d files, %d bytes at %d B/s - estimated %s at 0 replicas", sample.Files, sample.Bytes, sample.ReadBytesPerSecond, estimate)
+	if budget > 0 {
+		detail += fmt.Sprintf(" against a budget of %s", budget)
+	}
+	audit.record(ctx, "downtime-estimate", detail)
+	if budget <= 0 || estimate <= budget {
+		return estimate, nil
+	}
+
+	msg := "estimated downtime exceeds max_downtime: " + detail
+	if !precopy {
+		msg += " - COPY_MODE_SNAPSHOT_PRECOPY only copies the delta at 0 replicas"
+	}
+	return estimate, temporal.NewNonRetryableApplicationError(msg, "DowntimeBudgetExceeded", nil)
+}
+
+// copyWithinBudget runs the copy at 0 replicas but gives up on it once the sts has been down for `budget`
+// it returns the copy's stats, or nil when it didn't finish in time
+func copyWithinBudget(ctx workflow.Context, originalPVC, newPVC util.PvcInfo, ns string, zeroAt time.Time, budget, timeout time.Duration) (*proto.CopyProgress, error) {
+	var ja *activities.JobActivities
+	stats := &proto.CopyProgress{}
+	if budget <= 0 {
+		err := workflow.ExecuteActivity(withJobTimeout(ctx, timeout), ja.Runrclone, originalPVC, newPVC, ns).Get(ctx, &stats)
+		if err != nil {
+			return nil, err
+		}
+		return stats, nil
+	}
+
+	remaining := budget - workflow.Now(ctx).Sub(zeroAt)
+	if remaining <= 0 {
+		return nil, nil
+	}
+
+	copyCtx, cancelCopy := workflow.WithCancel(withJobTimeout(ctx, timeout))
+	// wait for the job to be cleaned up before anything touches the staging pvc
+	copyCtx = workflow.WithWaitForCancellation(copyCtx, true)
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	copied := workflow.ExecuteActivity(copyCtx, ja.Runrclone, originalPVC, newPVC, ns)
+	timer := workflow.NewTimer(timerCtx, remaining)
+
+	var err error
+	inTime := false
+	selector := workflow.NewSelector(ctx)
+	selector.AddFuture(copied, func(f workflow.Future) {
+		inTime = true
+		err = f.Get(ctx, &stats)
+	})
+	selector.AddFuture(timer, func(f workflow.Future) {
+		cancelCopy()
+		// the result is a cancellation, only wait for it
+		_ = copied.Get(ctx, nil)
+	})
+	selector.Select(ctx)
+	if !inTime || err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// abortBeforeCutover puts everything back the way it was while the original pvc is still bound
+// the safety snapshot and backup of the record protect nothing any more and are dropped too
+func abortBeforeCutover(ctx workflow.Context, record *util.ResizeRecord, newPVC util.PvcInfo, newPolicy corev1.PersistentVolumeReclaimPolicy, replicas int32) error {
+	ns, stsName, originalPV := record.Namespace, record.StatefulSet, record.OriginalPVC.VolumeName
+	workflow.GetLogger(ctx).Info("Rescaling sts on the original PV", "sts", stsName, "pv", originalPV)
+	err := scaleUp(ctx, ns, stsName, replicas)
+	if err != nil {
+		return err
+	}
+	err = releaseStaging(ctx, ns, originalPV, record.OriginalReclaimPolicy, newPVC, newPolicy)
+	if err != nil {
+		return err
+	}
+
+	if record.Snapshot != "" && !record.SnapshotDeleted {
+		err = deleteSnapshot(ctx, ns, record.Snapshot)
+		if err != nil {
+			return err
+		}
+		record.SnapshotDeleted = true
+	}
+	if record.Backup != nil {
+		err = deleteBackup(ctx, ns, *record.Backup)
+		if err != nil {
+			return err
+		}
+		record.Backup = nil
+	}
+	return nil
+}
+
+// releaseStaging restores both reclaim policies and drops the staging pvc (and with it the new pv)
+func releaseStaging(ctx workflow.Context, ns string, originalPV string, originalPolicy corev1.PersistentVolumeReclaimPolicy, newPVC util.PvcInfo, newPolicy corev1.PersistentVolumeReclaimPolicy) error {
+	logger := workflow.GetLogger(ctx)
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+
+	err := workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, originalPV, originalPolicy).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// the staging pv goes away with its claim once it's back on its own policy
+	err = workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, newPVC.VolumeName, newPolicy).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Dropping staging pvc", "pvc", newPVC.Name)
+	return workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, newPVC.Name).Get(ctx, nil)
+}
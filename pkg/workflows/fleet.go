@@ -0,0 +1,147 @@
+package workflows
+
+import (
+	"slices"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// fleetBatch is how many children a run starts before it continues as new
+// every child adds a handful of events to the parent's history
+const fleetBatch = 100
+
+// FleetResizeWorkflow resizes every target as a child ScaleDownWorkflow within the fleet's limits
+// once a batch of children is started it waits for them and continues as new with the targets left
+// nolint: funlen
+func FleetResizeWorkflow(ctx workflow.Context, input *proto.Fleet) (*proto.FleetReport, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting fleet", "targets", len(input.Targets), "done", len(input.Outcomes))
+
+	if err := util.ValidateFleet(input); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidFleet", err)
+	}
+
+	outcomes := input.Outcomes
+	pending := slices.Clone(input.Targets)
+	inFlight := map[string]int{}
+	running := 0
+	err := workflow.SetQueryHandler(ctx, util.FleetQuery, func() (*proto.FleetReport, error) {
+		return util.SummarizeFleet(outcomes, len(pending)+running), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	failures := util.SummarizeFleet(outcomes, 0).Failed
+	budgetSpent := func() bool {
+		return input.MaxFailures > 0 && failures >= input.MaxFailures
+	}
+	maxConcurrency := max(input.MaxConcurrency, 1)
+	eligible := func(target *proto.Scale) bool {
+		return input.MaxPerNamespace <= 0 || int32(inFlight[target.Namespace]) < input.MaxPerNamespace
+	}
+
+	started := 0
+	batchDone := func() bool {
+		return started >= fleetBatch || workflow.GetInfo(ctx).GetContinueAsNewSuggested()
+	}
+	selector := workflow.NewSelector(ctx)
+	for {
+		for int32(running) < maxConcurrency && !batchDone() && !budgetSpent() && ctx.Err() == nil {
+			i := slices.IndexFunc(pending, eligible)
+			if i < 0 {
+				break
+			}
+			target := pending[i]
+			pending = slices.Delete(pending, i, i+1)
+
+			id := util.ResizeWorkflowID(target.Namespace, target.Pvc)
+			childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+				WorkflowID:            id,
+				WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+				// cancelling the fleet cancels the running resizes, which undo what they got to - the fleet waits
+				// for that so its outcomes say how they ended. A terminated fleet still asks them to cancel
+				ParentClosePolicy:   enumspb.PARENT_CLOSE_POLICY_REQUEST_CANCEL,
+				WaitForCancellation: true,
+			})
+			logger.Info("Starting fleet target", "namespace", target.Namespace, "pvc", target.Pvc, "workflowID", id)
+			child := workflow.ExecuteChildWorkflow(childCtx, ScaleDownWorkflow, target)
+			inFlight[target.Namespace]++
+			running++
+			started++
+
+			selector.AddFuture(child, func(f workflow.Future) {
+				inFlight[target.Namespace]--
+				running--
+				outcome := &proto.FleetOutcome{Namespace: target.Namespace, Pvc: target.Pvc, WorkflowId: id}
+				var execution workflow.Execution
+				if child.GetChildWorkflowExecution().Get(ctx, &execution) == nil {
+					outcome.RunId = execution.RunID
+				}
+				result := &proto.ScaleResult{}
+				if err := f.Get(ctx, &result); err != nil {
+					logger.Warn("Fleet target failed", "workflowID", id, "error", err)
+					failures++
+					outcome.Status = proto.FleetTargetStatus_FLEET_TARGET_STATUS_FAILED
+					outcome.Error = err.Error()
+				} else {
+					outcome.Status = proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED
+					outcome.Result = result
+				}
+				outcomes = append(outcomes, outcome)
+			})
+		}
+
+		if running == 0 {
+			break
+		}
+		selector.Select(ctx)
+	}
+
+	if len(pending) > 0 && !budgetSpent() && ctx.Err() == nil {
+		logger.Info("Continuing fleet as new", "remaining", len(pending), "done", len(outcomes))
+		return nil, workflow.NewContinueAsNewError(ctx, FleetResizeWorkflow, &proto.Fleet{
+			Targets:         pending,
+			MaxConcurrency:  input.MaxConcurrency,
+			MaxPerNamespace: input.MaxPerNamespace,
+			MaxFailures:     input.MaxFailures,
+			Outcomes:        carriedOutcomes(outcomes),
+		})
+	}
+
+	for _, target := range pending {
+		outcomes = append(outcomes, &proto.FleetOutcome{
+			Namespace:  target.Namespace,
+			Pvc:        target.Pvc,
+			WorkflowId: util.ResizeWorkflowID(target.Namespace, target.Pvc),
+			Status:     proto.FleetTargetStatus_FLEET_TARGET_STATUS_SKIPPED,
+		})
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	report := util.SummarizeFleet(outcomes, 0)
+	logger.Info("Fleet done", "succeeded", report.Succeeded, "failed", report.Failed, "skipped", report.Skipped)
+	return report, nil
+}
+
+// carriedOutcomes strips the outcomes down to what identifies the child and how it ended
+// so the input of the next run doesn't grow with every result
+func carriedOutcomes(outcomes []*proto.FleetOutcome) []*proto.FleetOutcome {
+	carried := make([]*proto.FleetOutcome, 0, len(outcomes))
+	for _, o := range outcomes {
+		carried = append(carried, &proto.FleetOutcome{
+			Namespace:  o.Namespace,
+			Pvc:        o.Pvc,
+			WorkflowId: o.WorkflowId,
+			RunId:      o.RunId,
+			Status:     o.Status,
+		})
+	}
+	return carried
+}
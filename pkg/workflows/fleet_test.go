@@ -0,0 +1,93 @@
+package workflows_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestFleetResizeWorkflow(t *testing.T) {
+	targets := []*proto.Scale{
+		{Namespace: "db", Pvc: "data-db-0", Sts: "db", Size: "5Gi"},
+		{Namespace: "db", Pvc: "data-db-1", Sts: "db", Size: "5Gi"},
+		{Namespace: "queue", Pvc: "data-queue-0", Sts: "queue", Size: "5Gi"},
+	}
+
+	testCases := []struct {
+		Name  string
+		Fleet *proto.Fleet
+		// Failing are the pvcs whose resize fails
+		Failing   []string
+		Succeeded int32
+		Failed    int32
+		Skipped   int32
+		// ContinueAsNew is suggested while the first child runs
+		ContinueAsNew bool
+	}{
+		{Name: "all resized", Fleet: &proto.Fleet{Targets: targets, MaxConcurrency: 2, MaxPerNamespace: 1}, Succeeded: 3},
+		{Name: "failure budget", Fleet: &proto.Fleet{Targets: targets, MaxConcurrency: 1, MaxFailures: 1}, Failing: []string{"data-db-0"}, Failed: 1, Skipped: 2},
+		{Name: "unlimited failures", Fleet: &proto.Fleet{Targets: targets}, Failing: []string{"data-db-1"}, Succeeded: 2, Failed: 1},
+		{Name: "continued as new", Fleet: &proto.Fleet{Targets: targets, MaxConcurrency: 1}, ContinueAsNew: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var s testsuite.WorkflowTestSuite
+			env := s.NewTestWorkflowEnvironment()
+			env.RegisterWorkflow(workflows.ScaleDownWorkflow)
+			if tt.ContinueAsNew {
+				env.RegisterDelayedCallback(func() { env.SetContinueAsNewSuggested(true) }, 30*time.Minute)
+			}
+			env.OnWorkflow(workflows.ScaleDownWorkflow, mock.Anything, mock.Anything).Return(
+				func(ctx workflow.Context, input *proto.Scale) (*proto.ScaleResult, error) {
+					if err := workflow.Sleep(ctx, time.Hour); err != nil {
+						return nil, err
+					}
+					for _, pvc := range tt.Failing {
+						if pvc == input.Pvc {
+							return nil, errors.New("copy failed")
+						}
+					}
+					return &proto.ScaleResult{Namespace: input.Namespace, Pvc: input.Pvc, NewSize: input.Size}, nil
+				})
+
+			env.ExecuteWorkflow(workflows.FleetResizeWorkflow, tt.Fleet)
+			require.True(t, env.IsWorkflowCompleted())
+
+			if tt.ContinueAsNew {
+				var continued *workflow.ContinueAsNewError
+				require.ErrorAs(t, env.GetWorkflowError(), &continued)
+				env.AssertWorkflowNumberOfCalls(t, "ScaleDownWorkflow", 1)
+				// the next run gets the targets left and only the ids and statuses of the finished ones
+				next := &proto.Fleet{}
+				require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continued.Input, &next))
+				require.Len(t, next.Targets, 2)
+				require.Len(t, next.Outcomes, 1)
+				require.Equal(t, proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED, next.Outcomes[0].Status)
+				require.Nil(t, next.Outcomes[0].Result)
+				return
+			}
+			require.NoError(t, env.GetWorkflowError())
+			report := &proto.FleetReport{}
+			require.NoError(t, env.GetWorkflowResult(&report))
+			require.Equal(t, tt.Succeeded, report.Succeeded)
+			require.Equal(t, tt.Failed, report.Failed)
+			require.Equal(t, tt.Skipped, report.Skipped)
+			require.Len(t, report.Outcomes, len(targets))
+			for _, outcome := range report.Outcomes {
+				require.Equal(t, "pvscope/"+outcome.Namespace+"/"+outcome.Pvc, outcome.WorkflowId)
+				if outcome.Status == proto.FleetTargetStatus_FLEET_TARGET_STATUS_SUCCEEDED {
+					require.Equal(t, outcome.Pvc, outcome.Result.GetPvc())
+				}
+			}
+		})
+	}
+}
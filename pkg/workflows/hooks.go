@@ -0,0 +1,106 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// resolveHooks prefers hooks from the request and falls back to the ones annotated on the workload
+func resolveHooks(requested []*proto.Hook, annotations map[string]string, annotation string) ([]*proto.Hook, error) {
+	if len(requested) > 0 {
+		return requested, nil
+	}
+
+	hooks, err := util.ParseHooks(annotations[annotation])
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("invalid %s annotation: %s", annotation, err), "InvalidHook", err)
+	}
+	return hooks, nil
+}
+
+// withHookTimeout gives every hook without its own timeout the default one
+func withHookTimeout(hooks []*proto.Hook, timeout *durationpb.Duration) []*proto.Hook {
+	defaulted := make([]*proto.Hook, 0, len(hooks))
+	for _, hook := range hooks {
+		if hook.Timeout == nil {
+			hook = protobuf.Clone(hook).(*proto.Hook)
+			hook.Timeout = timeout
+		}
+		defaulted = append(defaulted, hook)
+	}
+	return defaulted
+}
+
+// hookNames is for showing hooks to a human
+func hookNames(hooks []*proto.Hook) []string {
+	names := make([]string, 0, len(hooks))
+	for _, hook := range hooks {
+		names = append(names, util.HookName(hook))
+	}
+	return names
+}
+
+// runHooks runs each hook in every running pod of the sts, one pod at a time
+// a failed hook either aborts the workflow or gets audited depending on its failure policy
+func runHooks(ctx workflow.Context, audit *auditLog, phase, ns, sts string, hooks []*proto.Hook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	logger := workflow.GetLogger(ctx)
+	var ha *activities.HookActivities
+
+	var pods []string
+	err := workflow.ExecuteActivity(ctx, ha.ListHookTargets, ns, sts).Get(ctx, &pods)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		name := util.HookName(hook)
+		timeout := activities.DefaultHookTimeout
+		if hook.Timeout != nil {
+			timeout = hook.Timeout.AsDuration()
+		}
+
+		// hooks aren't necessarily idempotent (e.g. leaving a cluster) so never retry them blindly
+		hctx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: timeout + 30*time.Second,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 1,
+			},
+		})
+
+		for _, pod := range pods {
+			logger.Info("Running hook", "phase", phase, "hook", name, "pod", pod)
+			result := activities.HookResult{}
+			err := workflow.ExecuteActivity(hctx, ha.RunHook, ns, pod, hook).Get(ctx, &result)
+			if err == nil && result.Error == "" {
+				continue
+			}
+
+			failure := result.Error
+			if err != nil {
+				failure = err.Error()
+			}
+			if hook.FailurePolicy == proto.HookFailurePolicy_HOOK_FAILURE_POLICY_CONTINUE {
+				audit.warn(ctx, phase+"-hook-failed", fmt.Sprintf("hook %q failed in pod %s: %s", name, pod, failure))
+				continue
+			}
+			return temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("%s hook %q failed in pod %s: %s", phase, name, pod, failure),
+				"HookFailed",
+				err,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package workflows
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/workflow"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// scaleRequestChange marks ScaleDownWorkflow running as a ScaleWorkflow
+// runs started before it replay legacyScaleDown so they can drain after an upgrade
+const scaleRequestChange = "scale-request"
+
+// legacyScaleDown is the resize ScaleDownWorkflow ran before ScaleWorkflow - its steps must not change
+// only the record query is new, so the resizes it finished can still be rolled back
+// nolint: funlen
+func legacyScaleDown(ctx workflow.Context, input *proto.Scale) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting legacy workflow", "namespace", input.Namespace, "newSize", input.Size, "pvcTarget", input.Pvc, "sts", input.Sts)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+
+	record := &util.ResizeRecord{Namespace: input.Namespace, StatefulSet: input.Sts, NewSize: input.Size}
+	err := workflow.SetQueryHandler(ctx, util.RecordQuery, func() (*util.ResizeRecord, error) {
+		return record, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var ja *activities.JobActivities
+	var sts *activities.STSActivities
+
+	// get original PVC
+	logger.Info("Getting the original PVC", "pvc", input.Pvc, "namespace", input.Namespace)
+	originalPVC := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.GetPVC, input.Namespace, input.Pvc).Get(ctx, &originalPVC)
+	if err != nil {
+		return err
+	}
+	logger.Debug("Original pvc", "volume", originalPVC.VolumeName, "name", originalPVC.Namespace, "originalStorage", originalPVC.RequestedStorage)
+
+	// mark existing pv safe (retain)
+	logger.Info("Marging the original pv retain", "pv", originalPVC.VolumeName)
+	var originalRetentionPolicy corev1.PersistentVolumeReclaimPolicy
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, originalPVC.VolumeName).Get(ctx, &originalRetentionPolicy)
+	if err != nil {
+		return err
+	}
+	logger.Debug("pv retention", "original", originalRetentionPolicy)
+	record.OriginalPVC = originalPVC
+	record.OriginalReclaimPolicy = originalRetentionPolicy
+
+	// create new PVC / provision new PV
+	logger.Info("Provisioning PVC of new size", "newSize", input.Size)
+	newPVC := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.CreateStagingPVC, originalPVC, input.Size).Get(ctx, &newPVC)
+	if err != nil {
+		return err
+	}
+	logger.Debug("New pvc", "name", newPVC.Name, "size", newPVC.RequestedStorage, "volume", newPVC.VolumeName)
+	record.NewPV = newPVC.VolumeName
+
+	// make sure new PV is safe
+	logger.Info("Ensuring that new PV is retain")
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, newPVC.VolumeName).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// getting initial starting point for replicas
+	logger.Info("Getting starting point for replicas", "sts", input.Sts)
+	var initialReplicas int32
+	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, input.Namespace, input.Sts).Get(ctx, &initialReplicas)
+	if err != nil {
+		return err
+	}
+	logger.Debug("Found replicas", "count", initialReplicas)
+	record.InitialReplicas = initialReplicas
+
+	// scaling sts to 0
+	logger.Info("Scaling sts to 0", "sts", input.Sts)
+	err = workflow.ExecuteActivity(ctx, sts.ScaleTo0, input.Namespace, input.Sts).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// activity options aren't part of the history so the copy gets the job timeouts it has everywhere else
+	logger.Info("Creating RClone job", "originalPVC", originalPVC.Name, "newPVC", newPVC.Name, "originalSize", originalPVC.RequestedStorage, "newSize", newPVC.RequestedStorage)
+	err = workflow.ExecuteActivity(withJobOptions(ctx), ja.Runrclone, originalPVC, newPVC, input.Namespace).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// drop both pvs
+	logger.Info("Dropping pvc", "pvc", originalPVC.Name)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, input.Namespace, originalPVC.Name).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Dropping pvc", "pvc", newPVC.Name)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, input.Namespace, newPVC.Name).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// map the new pv to the original pvc
+	logger.Info("Rebinding original PVC name to new PV", "newPV", newPVC.VolumeName, "originalPVC", originalPVC.Name, "newSize", input.Size)
+	err = workflow.ExecuteActivity(ctx, pvca.RebindPV, input.Namespace, newPVC.VolumeName, originalPVC, input.Size).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Resetting reclaim policy on new PV", "pv", newPVC.VolumeName, "originalPolicy", originalRetentionPolicy)
+	err = workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, newPVC.VolumeName, originalRetentionPolicy).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Rescaling sts", "sts", input.Sts)
+	err = workflow.ExecuteActivity(ctx, sts.ScaleUp, input.Namespace, input.Sts, initialReplicas).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// the original pv was left retained so the resize can be rolled back
+	record.Completed = true
+
+	logger.Info("Workflow done")
+	return nil
+}
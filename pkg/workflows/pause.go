@@ -0,0 +1,80 @@
+package workflows
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// pauser parks the workflow at breakpoints without cancelling it - cancelling would trigger cleanup
+type pauser struct {
+	audit       *auditLog
+	breakpoints map[string]bool
+	paused      bool
+	parked      *util.Parked
+}
+
+// newPauser validates the requested breakpoints and starts listening for pause and resume signals
+func newPauser(ctx workflow.Context, audit *auditLog, breakpoints []string) (*pauser, error) {
+	p := &pauser{audit: audit, breakpoints: map[string]bool{}}
+	for _, bp := range breakpoints {
+		if !slices.Contains(util.Breakpoints, bp) {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown breakpoint %q", bp), "InvalidBreakpoint", nil)
+		}
+		p.breakpoints[bp] = true
+	}
+
+	err := workflow.SetQueryHandler(ctx, util.ParkedQuery, func() (*util.Parked, error) {
+		return p.parked, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pause := workflow.GetSignalChannel(ctx, util.PauseSignal)
+	resume := workflow.GetSignalChannel(ctx, util.ResumeSignal)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			selector := workflow.NewSelector(ctx)
+			selector.AddReceive(pause, func(c workflow.ReceiveChannel, more bool) {
+				c.Receive(ctx, nil)
+				audit.record(ctx, "pause-requested", "parking at the next breakpoint")
+				p.paused = true
+			})
+			selector.AddReceive(resume, func(c workflow.ReceiveChannel, more bool) {
+				c.Receive(ctx, nil)
+				p.paused = false
+			})
+			selector.Select(ctx)
+		}
+	})
+	return p, nil
+}
+
+// checkpoint blocks at the breakpoint if it was requested or a pause is pending
+func (p *pauser) checkpoint(ctx workflow.Context, breakpoint string) error {
+	reason := ""
+	switch {
+	case p.paused:
+		reason = "pause signal"
+	case p.breakpoints[breakpoint]:
+		reason = "breakpoint"
+		p.paused = true
+	default:
+		return nil
+	}
+
+	workflow.GetLogger(ctx).Info("Parked", "breakpoint", breakpoint, "reason", reason)
+	p.audit.record(ctx, "parked", fmt.Sprintf("%s (%s)", breakpoint, reason))
+	p.parked = &util.Parked{Breakpoint: breakpoint, Since: workflow.Now(ctx), Reason: reason}
+	err := workflow.Await(ctx, func() bool { return !p.paused })
+	if err != nil {
+		return err
+	}
+	p.parked = nil
+	p.audit.record(ctx, "resumed", breakpoint)
+	return nil
+}
@@ -0,0 +1,126 @@
+package workflows
+
+import (
+	"fmt"
+	"strings"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// planResize works out every change the request would make without making any of them
+// writes are server-side dry runs so admission and rbac still get their say
+// nolint: funlen
+func planResize(ctx workflow.Context, req *protov2.ScaleRequest, audit *auditLog, record *util.ResizeRecord, originalPVC util.PvcInfo, preHooks, postHooks []*proto.Hook, window *proto.MaintenanceWindow) (*proto.ScaleResult, error) {
+	logger := workflow.GetLogger(ctx)
+	var pa *activities.PlanActivities
+	ns, stsName, size := req.Workload.Namespace, req.Workload.Statefulset, req.Target.Size
+	options := req.GetOptions()
+
+	logger.Info("Planning resize", "pvc", originalPVC.Name, "newSize", size)
+	var originalPolicy corev1.PersistentVolumeReclaimPolicy
+	err := workflow.ExecuteActivity(ctx, pa.PlanRetain, originalPVC.VolumeName).Get(ctx, &originalPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	staging := &proto.Manifest{}
+	err = workflow.ExecuteActivity(ctx, pa.PlanStagingPVC, originalPVC, size).Get(ctx, &staging)
+	if err != nil {
+		return nil, err
+	}
+
+	var replicas int32
+	err = workflow.ExecuteActivity(ctx, pa.PlanScaleDown, ns, stsName).Get(ctx, &replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &proto.Manifest{}
+	err = workflow.ExecuteActivity(ctx, pa.PlanCopyJob, originalPVC).Get(ctx, &job)
+	if err != nil {
+		return nil, err
+	}
+
+	rebound := &proto.Manifest{}
+	err = workflow.ExecuteActivity(ctx, pa.PlanRebind, originalPVC, size).Get(ctx, &rebound)
+	if err != nil {
+		return nil, err
+	}
+
+	// sampling would start a job so assume the worst case for the volume's size
+	capacity, err := resource.ParseQuantity(originalPVC.RequestedStorage)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to parse original size")
+	}
+	precopy := options.GetMover().GetCopyMode() == proto.CopyMode_COPY_MODE_SNAPSHOT_PRECOPY
+	estimate := util.EstimateDowntime(util.AssumedSample(capacity.Value()), precopy)
+	if maxDowntime := options.GetSafety().GetMaxDowntime().AsDuration(); maxDowntime > 0 && estimate > maxDowntime {
+		audit.warn(ctx, "downtime-estimate", fmt.Sprintf("assumed %s at 0 replicas exceeds max_downtime %s - the real run samples the volume first", estimate, maxDowntime))
+	}
+	record.OriginalPVC = originalPVC
+	record.OriginalReclaimPolicy = originalPolicy
+	record.InitialReplicas = replicas
+	record.EstimatedDowntime = estimate
+
+	steps := []*proto.PlanStep{}
+	step := func(name, format string, args ...any) {
+		steps = append(steps, &proto.PlanStep{Name: name, Detail: fmt.Sprintf(format, args...)})
+	}
+	step("protect-original", "set the reclaim policy of pv %s from %s to Retain", originalPVC.VolumeName, originalPolicy)
+	step("provision-staging", "create a staging pvc of %s and set its new pv to Retain", size)
+	if precopy {
+		step("precopy", "snapshot pvc %s and copy the snapshot into the staging pvc while %s keeps running", originalPVC.Name, stsName)
+	}
+	if options.GetSafety().GetApproval().GetRequired() {
+		step("approval", "wait for an approval decision")
+	}
+	if window != nil {
+		step("maintenance-window", "wait for the %s-%s %s window on %s", window.Start, window.End, window.Timezone, strings.Join(window.Days, ","))
+	}
+	if len(preHooks) > 0 {
+		step("pre-quiesce-hooks", "run %s in every pod", strings.Join(hookNames(preHooks), ", "))
+	}
+	step("scale-down", "scale sts %s from %d to 0 replicas", stsName, replicas)
+	if options.GetRetention().GetSnapshot().GetEnabled() {
+		step("snapshot", "snapshot pvc %s", originalPVC.Name)
+	}
+	if options.GetRetention().GetBackup().GetEnabled() {
+		step("backup", "copy pvc %s to bucket %s", originalPVC.Name, options.GetRetention().GetBackup().GetBucket())
+	}
+	step("copy", "sync pvc %s (%s) into the staging pvc - about %s at 0 replicas", originalPVC.Name, originalPVC.RequestedStorage, estimate)
+	step("delete-original", "delete pvc %s and its staging pvc, both pvs are retained", originalPVC.Name)
+	step("rebind", "create pvc %s of %s bound to the new pv", originalPVC.Name, size)
+	step("scale-up", "scale sts %s from 0 to %d replicas", stsName, replicas)
+	if len(postHooks) > 0 {
+		step("post-resume-hooks", "run %s in every pod", strings.Join(hookNames(postHooks), ", "))
+	}
+	if options.GetVerification() != nil {
+		step("verify", "verify sts %s and roll back to pv %s on failure", stsName, originalPVC.VolumeName)
+	}
+	step("reset-policy", "set the reclaim policy of the new pv to %s", originalPolicy)
+	if retainFor := options.GetRetention().GetRetainOriginalFor().AsDuration(); retainFor > 0 {
+		step("retain-original", "delete pv %s after %s", originalPVC.VolumeName, retainFor)
+	} else {
+		step("retain-original", "keep pv %s with reclaim policy Retain", originalPVC.VolumeName)
+	}
+
+	result, err := scaleResult(ctx, options.GetVerification(), record, nil, audit.warnings)
+	if err != nil {
+		return nil, err
+	}
+	result.DryRun = true
+	result.Plan = &proto.ScalePlan{
+		Steps:            steps,
+		ExpectedDowntime: durationpb.New(estimate),
+		Manifests:        []*proto.Manifest{staging, job, rebound},
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package workflows
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/workflow"
+)
+
+// quiesced runs fn while the sts is scaled to 0 (after its pre-quiesce hooks) and always scales it
+// back to where it was afterwards, even if fn fails
+// unlike a resize nothing here is destructive so there's never a reason to leave the sts down
+func quiesced(ctx workflow.Context, audit *auditLog, ns, stsName string, preHooks, postHooks []*proto.Hook, fn func() error) error {
+	logger := workflow.GetLogger(ctx)
+	var sts *activities.STSActivities
+	var ha *activities.HookActivities
+
+	hookAnnotations := map[string]string{}
+	err := workflow.ExecuteActivity(ctx, ha.GetHookAnnotations, ns, stsName).Get(ctx, &hookAnnotations)
+	if err != nil {
+		return err
+	}
+	preHooks, err = resolveHooks(preHooks, hookAnnotations, util.PreHookAnnotation)
+	if err != nil {
+		return err
+	}
+	postHooks, err = resolveHooks(postHooks, hookAnnotations, util.PostHookAnnotation)
+	if err != nil {
+		return err
+	}
+
+	var replicas int32
+	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, ns, stsName).Get(ctx, &replicas)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Running pre-quiesce hooks", "sts", stsName, "count", len(preHooks))
+	err = runHooks(ctx, audit, "pre-quiesce", ns, stsName, preHooks)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Scaling sts to 0", "sts", stsName)
+	err = workflow.ExecuteActivity(ctx, sts.ScaleTo0, ns, stsName).Get(ctx, nil)
+	if err == nil {
+		err = fn()
+	}
+
+	// resume even if the workflow is being cancelled
+	resumeCtx, cancel := workflow.NewDisconnectedContext(ctx)
+	defer cancel()
+	resumeErr := scaleUp(resumeCtx, ns, stsName, replicas)
+	if err != nil {
+		return err
+	}
+	if resumeErr != nil {
+		return resumeErr
+	}
+
+	logger.Info("Running post-resume hooks", "sts", stsName, "count", len(postHooks))
+	return runHooks(ctx, audit, "post-resume", ns, stsName, postHooks)
+}
@@ -0,0 +1,185 @@
+package workflows_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/worker"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// the claim every test resizes from 10Gi to 5Gi and the staging claim it's copied to
+var (
+	original = util.PvcInfo{Name: "data-db-0", Namespace: "db", VolumeName: "pv-original", StorageClassName: "standard", RequestedStorage: "10Gi"}
+	staging  = util.PvcInfo{Name: "data-db-0-staging", Namespace: "db", VolumeName: "pv-staging", StorageClassName: "standard", RequestedStorage: "5Gi"}
+)
+
+// legacyStep is an activity the original ScaleDownWorkflow ran and what it returned
+type legacyStep struct {
+	Activity string
+	Result   any
+}
+
+// legacySteps are the activities of a ScaleDownWorkflow run by a worker from before ScaleWorkflow, in order
+var legacySteps = []legacyStep{
+	{"GetPVC", original},
+	{"EnsureReclaimPolicyRetain", corev1.PersistentVolumeReclaimDelete},
+	{"CreateStagingPVC", staging},
+	{"EnsureReclaimPolicyRetain", corev1.PersistentVolumeReclaimDelete},
+	{"GetInitialReplicase", int64(3)},
+	{"ScaleTo0", nil},
+	{"Runrclone", nil},
+	{"DeletePVC", nil},
+	{"DeletePVC", nil},
+	{"RebindPV", nil},
+	{"SetReclaimPolicy", nil},
+	{"ScaleUp", nil},
+}
+
+// historyBuilder writes the events the server would record for a workflow running one activity per task
+type historyBuilder struct {
+	t      *testing.T
+	events []*historypb.HistoryEvent
+	// lastTask is the id of the last WorkflowTaskCompleted
+	lastTask int64
+}
+
+func (b *historyBuilder) add(eventType enumspb.EventType, attributes func(e *historypb.HistoryEvent)) int64 {
+	e := &historypb.HistoryEvent{
+		EventId:   int64(len(b.events) + 1),
+		EventTime: timestamppb.New(time.Date(2025, 1, 1, 0, 0, len(b.events), 0, time.UTC)),
+		EventType: eventType,
+	}
+	attributes(e)
+	b.events = append(b.events, e)
+	return e.EventId
+}
+
+func (b *historyBuilder) payloads(values ...any) *commonpb.Payloads {
+	if len(values) == 1 && values[0] == nil {
+		return nil
+	}
+	payloads, err := converter.GetDefaultDataConverter().ToPayloads(values...)
+	require.NoError(b.t, err)
+	return payloads
+}
+
+func (b *historyBuilder) started(workflowType string, input ...any) {
+	b.add(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+			WorkflowType:        &commonpb.WorkflowType{Name: workflowType},
+			TaskQueue:           &taskqueuepb.TaskQueue{Name: workflows.TaskQueueName},
+			Input:               b.payloads(input...),
+			WorkflowTaskTimeout: durationpb.New(10 * time.Second),
+			Attempt:             1,
+		}}
+	})
+}
+
+// workflowTask schedules and starts a task, and completes it unless the worker is still on it
+func (b *historyBuilder) workflowTask(complete bool) {
+	scheduled := b.add(enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{
+			TaskQueue:           &taskqueuepb.TaskQueue{Name: workflows.TaskQueueName},
+			StartToCloseTimeout: durationpb.New(10 * time.Second),
+			Attempt:             1,
+		}}
+	})
+	started := b.add(enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{
+			ScheduledEventId: scheduled,
+		}}
+	})
+	if !complete {
+		return
+	}
+	b.lastTask = b.add(enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{
+			ScheduledEventId: scheduled,
+			StartedEventId:   started,
+		}}
+	})
+}
+
+// activity runs an activity to completion - the sdk uses the scheduled event's id as the activity id
+func (b *historyBuilder) activity(name string, result any) {
+	scheduled := b.add(enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+			ActivityId:                   strconv.Itoa(len(b.events) + 1),
+			ActivityType:                 &commonpb.ActivityType{Name: name},
+			TaskQueue:                    &taskqueuepb.TaskQueue{Name: workflows.TaskQueueName},
+			StartToCloseTimeout:          durationpb.New(time.Minute),
+			WorkflowTaskCompletedEventId: b.lastTask,
+		}}
+	})
+	started := b.add(enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{
+			ScheduledEventId: scheduled,
+			Attempt:          1,
+		}}
+	})
+	b.add(enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+			Result:           b.payloads(result),
+			ScheduledEventId: scheduled,
+			StartedEventId:   started,
+		}}
+	})
+}
+
+func (b *historyBuilder) completed() {
+	b.add(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED, func(e *historypb.HistoryEvent) {
+		e.Attributes = &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{
+			WorkflowTaskCompletedEventId: b.lastTask,
+		}}
+	})
+}
+
+// legacyHistory is the history of a legacy ScaleDownWorkflow that got through the first `steps` activities
+func legacyHistory(t *testing.T, steps int) *historypb.History {
+	b := &historyBuilder{t: t}
+	b.started("ScaleDownWorkflow", &proto.Scale{Namespace: "db", Pvc: "data-db-0", Sts: "db", Size: "5Gi"})
+	for _, step := range legacySteps[:steps] {
+		b.workflowTask(true)
+		b.activity(step.Activity, step.Result)
+	}
+	finished := steps == len(legacySteps)
+	b.workflowTask(finished)
+	if finished {
+		b.completed()
+	}
+	return &historypb.History{Events: b.events}
+}
+
+func TestReplayLegacyScaleDown(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Steps int
+	}{
+		{Name: "started", Steps: 0},
+		{Name: "provisioned", Steps: 3},
+		{Name: "scaled to 0", Steps: 6},
+		{Name: "rebound", Steps: 10},
+		{Name: "finished", Steps: len(legacySteps)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			replayer := worker.NewWorkflowReplayer()
+			replayer.RegisterWorkflow(workflows.ScaleDownWorkflow)
+			require.NoError(t, replayer.ReplayWorkflowHistory(nil, legacyHistory(t, tt.Steps)))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// retainOriginalPV durably waits out the retention period (or a signal) and then deletes the original pv
+// the record follows the decision so a rollback can tell whether the pv is still safe to use
+func retainOriginalPV(ctx workflow.Context, audit *auditLog, record *util.ResizeRecord, retainFor time.Duration) error {
+	logger := workflow.GetLogger(ctx)
+	pvName, policy := record.OriginalPVC.VolumeName, record.OriginalReclaimPolicy
+	logger.Info("Retaining original PV", "pv", pvName, "duration", retainFor)
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	timer := workflow.NewTimer(timerCtx, retainFor)
+	signals := workflow.GetSignalChannel(ctx, util.OriginalPVSignal)
+
+	decided := false
+	deleteOriginal := false
+	for !decided {
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(timer, func(f workflow.Future) {
+			if temporal.IsCanceledError(f.Get(ctx, nil)) {
+				// cancelling a finished resize only stops it from deleting the original pv
+				audit.record(ctx, "original-pv-kept", pvName+" (resize cancelled)")
+				decided, deleteOriginal = true, false
+				return
+			}
+			decided, deleteOriginal = true, true
+		})
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, more bool) {
+			var action string
+			c.Receive(ctx, &action)
+			switch action {
+			case util.OriginalPVDelete:
+				audit.record(ctx, "original-pv-delete-early", pvName)
+				decided, deleteOriginal = true, true
+			case util.OriginalPVKeep:
+				audit.record(ctx, "original-pv-kept", pvName)
+				decided, deleteOriginal = true, false
+			default:
+				logger.Warn("Ignoring unknown original-pv signal", "action", action)
+			}
+		})
+		selector.Select(ctx)
+	}
+
+	if !deleteOriginal {
+		logger.Info("Keeping original PV", "pv", pvName)
+		record.OriginalPVKept = true
+		return nil
+	}
+	record.OriginalPVDeleting = true
+
+	var pva *activities.PVActivities
+	logger.Info("Deleting original PV", "pv", pvName, "originalPolicy", policy)
+	dctx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 6 * time.Minute,
+	})
+	err := workflow.ExecuteActivity(dctx, pva.DeletePV, pvName, policy).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	record.OriginalPVDeleted = true
+	return nil
+}
@@ -0,0 +1,256 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// rollbackToOriginal binds the original pv back under the claim name at its original size
+// the pv currently behind the claim must already be retained - it's left released for inspection
+func rollbackToOriginal(ctx workflow.Context, ns, stsName string, originalPVC util.PvcInfo, originalPolicy corev1.PersistentVolumeReclaimPolicy, replicas int32) error {
+	logger := workflow.GetLogger(ctx)
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var sts *activities.STSActivities
+
+	logger.Info("Scaling sts to 0 for rollback", "sts", stsName)
+	err := workflow.ExecuteActivity(ctx, sts.ScaleTo0, ns, stsName).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Dropping pvc", "pvc", originalPVC.Name)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, originalPVC.Name).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Rebinding original PVC name to original PV", "pv", originalPVC.VolumeName, "pvc", originalPVC.Name, "size", originalPVC.RequestedStorage)
+	err = workflow.ExecuteActivity(ctx, pvca.RebindPV, ns, originalPVC.VolumeName, originalPVC, originalPVC.RequestedStorage).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Resetting reclaim policy on original PV", "pv", originalPVC.VolumeName, "originalPolicy", originalPolicy)
+	err = workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, originalPVC.VolumeName, originalPolicy).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return scaleUp(ctx, ns, stsName, replicas)
+}
+
+const (
+	rollbackSuffix = "-rollback"
+	restoreSuffix  = "-restore"
+)
+
+// RollbackWorkflow undoes a completed ScaleDownWorkflow by swapping the claim back to the retained original pv
+// nolint: funlen
+func RollbackWorkflow(ctx workflow.Context, input *proto.Rollback) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting rollback", "workflowID", input.WorkflowId, "sync", input.Sync)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	audit, err := newAuditLog(ctx)
+	if err != nil {
+		return err
+	}
+
+	var wa *activities.WorkflowActivities
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var ja *activities.JobActivities
+	var sts *activities.STSActivities
+	var ha *activities.HookActivities
+
+	logger.Info("Getting resize record", "workflowID", input.WorkflowId)
+	record := util.ResizeRecord{}
+	err = workflow.ExecuteActivity(ctx, wa.GetResizeRecord, input.WorkflowId).Get(ctx, &record)
+	if err != nil {
+		return err
+	}
+	if !record.Completed {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("resize %s never completed - nothing to roll back", input.WorkflowId), "NotRollbackable", nil)
+	}
+	fromSnapshot := input.Source == proto.RollbackSource_ROLLBACK_SOURCE_SNAPSHOT
+	fromBackup := input.Source == proto.RollbackSource_ROLLBACK_SOURCE_BACKUP
+	if fromSnapshot && (record.Snapshot == "" || record.SnapshotDeleted) {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("resize %s has no snapshot to restore", input.WorkflowId), "NotRollbackable", nil)
+	}
+	if fromBackup && record.Backup == nil {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("resize %s has no backup to restore", input.WorkflowId), "NotRollbackable", nil)
+	}
+	if input.Source == proto.RollbackSource_ROLLBACK_SOURCE_ORIGINAL_PV && record.OriginalPVDeleted {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("original pv %s of %s was already deleted", record.OriginalPVC.VolumeName, input.WorkflowId), "NotRollbackable", nil)
+	}
+	ns := record.Namespace
+	originalPVC := record.OriginalPVC
+	originalPolicy := record.OriginalReclaimPolicy
+
+	// the resize workflow may still be counting down to deleting the original pv - once it has acted on the
+	// keep signal its record says whether the pv was kept or was already on its way out
+	logger.Info("Keeping original PV", "pv", originalPVC.VolumeName)
+	err = workflow.ExecuteActivity(ctx, wa.KeepOriginalPV, input.WorkflowId).Get(ctx, &record)
+	if err != nil {
+		return err
+	}
+	if !fromSnapshot && !fromBackup {
+		if record.OriginalPVDeleted || record.OriginalPVDeleting {
+			return temporal.NewNonRetryableApplicationError(fmt.Sprintf("original pv %s of %s was deleted before it could be kept", originalPVC.VolumeName, input.WorkflowId), "NotRollbackable", nil)
+		}
+		err = workflow.ExecuteActivity(ctx, pva.CheckPVAvailable, originalPVC.VolumeName).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	hookAnnotations := map[string]string{}
+	err = workflow.ExecuteActivity(ctx, ha.GetHookAnnotations, ns, record.StatefulSet).Get(ctx, &hookAnnotations)
+	if err != nil {
+		return err
+	}
+	preHooks, err := resolveHooks(input.PreQuiesceHooks, hookAnnotations, util.PreHookAnnotation)
+	if err != nil {
+		return err
+	}
+	postHooks, err := resolveHooks(input.PostResumeHooks, hookAnnotations, util.PostHookAnnotation)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Getting current PVC", "pvc", originalPVC.Name)
+	currentPVC := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.GetPVC, ns, originalPVC.Name).Get(ctx, &currentPVC)
+	if err != nil {
+		return err
+	}
+
+	// the resized volume is kept around in case the rollback itself was a mistake
+	logger.Info("Marking the current pv retain", "pv", currentPVC.VolumeName)
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, currentPVC.VolumeName).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var replicas int32
+	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, ns, record.StatefulSet).Get(ctx, &replicas)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Running pre-quiesce hooks", "sts", record.StatefulSet, "count", len(preHooks))
+	err = runHooks(ctx, audit, "pre-quiesce", ns, record.StatefulSet, preHooks)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Scaling sts to 0", "sts", record.StatefulSet)
+	err = workflow.ExecuteActivity(ctx, sts.ScaleTo0, ns, record.StatefulSet).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if fromSnapshot || fromBackup {
+		// a restored volume stands in for the original pv from here on
+		snapshot := ""
+		if fromSnapshot {
+			snapshot = record.Snapshot
+		}
+		var backup *util.BackupInfo
+		if fromBackup {
+			backup = record.Backup
+		}
+		originalPVC, err = restoreVolume(ctx, originalPVC, snapshot, backup, record.CopyTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	if input.Sync {
+		// the original pv needs a claim of its own before a job can mount it
+		syncPVC := originalPVC
+		syncPVC.Name = originalPVC.Name + rollbackSuffix
+		logger.Info("Binding original PV to a temporary PVC", "pv", originalPVC.VolumeName, "pvc", syncPVC.Name)
+		err = workflow.ExecuteActivity(ctx, pvca.RebindPV, ns, originalPVC.VolumeName, syncPVC, originalPVC.RequestedStorage).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Creating RClone job", "currentPVC", currentPVC.Name, "originalPVC", syncPVC.Name)
+		err = workflow.ExecuteActivity(withJobOptions(ctx), ja.Runrclone, currentPVC, syncPVC, ns).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("Dropping pvc", "pvc", syncPVC.Name)
+		err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, syncPVC.Name).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rollbackToOriginal(ctx, ns, record.StatefulSet, originalPVC, originalPolicy, replicas)
+	if err != nil {
+		return err
+	}
+	audit.record(ctx, "rolled-back", fmt.Sprintf("claim %s rebound to original pv %s, pv %s left retained", originalPVC.Name, originalPVC.VolumeName, currentPVC.VolumeName))
+
+	logger.Info("Running post-resume hooks", "sts", record.StatefulSet, "count", len(postHooks))
+	err = runHooks(ctx, audit, "post-resume", ns, record.StatefulSet, postHooks)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Rollback done")
+	return nil
+}
+
+// restoreVolume provisions a volume at the original size, filled from the snapshot or the backup,
+// and frees it from its temporary claim
+// the returned pvc looks like the original one but points at the restored pv
+func restoreVolume(ctx workflow.Context, originalPVC util.PvcInfo, snapshot string, backup *util.BackupInfo, copyTimeout time.Duration) (util.PvcInfo, error) {
+	logger := workflow.GetLogger(ctx)
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+
+	restoreName := originalPVC.Name + restoreSuffix
+	logger.Info("Provisioning restore PVC", "snapshot", snapshot, "pvc", restoreName)
+	restored := util.PvcInfo{}
+	err := workflow.ExecuteActivity(ctx, pvca.CreateRestorePVC, originalPVC, restoreName, snapshot).Get(ctx, &restored)
+	if err != nil {
+		return restored, err
+	}
+
+	if backup != nil {
+		// the restore gets as long as the resize gave its own copies
+		if copyTimeout == 0 {
+			copyTimeout = activities.JobTimeout
+		}
+		err = restoreBackup(ctx, restored, *backup, copyTimeout)
+		if err != nil {
+			return restored, err
+		}
+	}
+
+	logger.Info("Marking the restored pv retain", "pv", restored.VolumeName)
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, restored.VolumeName).Get(ctx, nil)
+	if err != nil {
+		return restored, err
+	}
+
+	logger.Info("Dropping pvc", "pvc", restoreName)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, originalPVC.Namespace, restoreName).Get(ctx, nil)
+	if err != nil {
+		return restored, err
+	}
+
+	originalPVC.VolumeName = restored.VolumeName
+	return originalPVC, nil
+}
@@ -0,0 +1,104 @@
+package workflows_test
+
+import (
+	"testing"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// newRollbackEnv mocks every activity of rolling back the resize of data-db-0 with the given record
+// kept is the record once the resize acted on the keep signal
+func newRollbackEnv(record, kept *util.ResizeRecord) *testsuite.TestWorkflowEnvironment {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	var wa *activities.WorkflowActivities
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var sts *activities.STSActivities
+	var ha *activities.HookActivities
+	resized := original
+	resized.VolumeName = "pv-staging"
+	restored := original
+	restored.Name = "data-db-0-restore"
+	restored.VolumeName = "pv-restore"
+	env.OnActivity(wa.GetResizeRecord, mock.Anything, "pvscope/db/data-db-0").Return(record, nil)
+	env.OnActivity(wa.KeepOriginalPV, mock.Anything, "pvscope/db/data-db-0").Return(kept, nil)
+	env.OnActivity(pva.CheckPVAvailable, mock.Anything, "pv-original").Return(nil)
+	env.OnActivity(ha.GetHookAnnotations, mock.Anything, "db", "db").Return(map[string]string{}, nil)
+	env.OnActivity(pvca.GetPVC, mock.Anything, "db", "data-db-0").Return(&resized, nil)
+	env.OnActivity(pva.EnsureReclaimPolicyRetain, mock.Anything, mock.Anything).Return(corev1.PersistentVolumeReclaimRetain, nil)
+	env.OnActivity(sts.GetInitialReplicase, mock.Anything, "db", "db").Return(int32(3), nil)
+	env.OnActivity(sts.ScaleTo0, mock.Anything, "db", "db").Return(nil)
+	env.OnActivity(pvca.CreateRestorePVC, mock.Anything, mock.Anything, "data-db-0-restore", record.Snapshot).Return(&restored, nil)
+	env.OnActivity(pvca.DeletePVC, mock.Anything, "db", mock.Anything).Return(nil)
+	env.OnActivity(pvca.RebindPV, mock.Anything, "db", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(pva.SetReclaimPolicy, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sts.SetReplicas, mock.Anything, "db", "db", int32(3)).Return(nil)
+	env.OnActivity(sts.WaitReady, mock.Anything, "db", "db", int32(3)).Return(nil)
+	return env
+}
+
+func TestRollbackWorkflow(t *testing.T) {
+	completed := util.ResizeRecord{
+		Namespace:             "db",
+		StatefulSet:           "db",
+		OriginalPVC:           original,
+		OriginalReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		NewPV:                 "pv-staging",
+		NewSize:               "5Gi",
+		InitialReplicas:       3,
+		Snapshot:              "data-db-0-pvscope-12345678-safety",
+		Completed:             true,
+	}
+	with := func(change func(r *util.ResizeRecord)) util.ResizeRecord {
+		r := completed
+		change(&r)
+		return r
+	}
+
+	testCases := []struct {
+		Name   string
+		Source proto.RollbackSource
+		Record util.ResizeRecord
+		// Kept is the record once the resize acted on the keep signal
+		Kept util.ResizeRecord
+		// RestoredPV is the pv the claim ends up on
+		RestoredPV string
+		Error      string
+	}{
+		{Name: "original pv", Record: completed, Kept: with(func(r *util.ResizeRecord) { r.OriginalPVKept = true }), RestoredPV: "pv-original"},
+		{Name: "snapshot", Source: proto.RollbackSource_ROLLBACK_SOURCE_SNAPSHOT, Record: completed, Kept: completed, RestoredPV: "pv-restore"},
+		{Name: "never completed", Record: with(func(r *util.ResizeRecord) { r.Completed = false }), Error: "NotRollbackable"},
+		{Name: "no snapshot", Source: proto.RollbackSource_ROLLBACK_SOURCE_SNAPSHOT, Record: with(func(r *util.ResizeRecord) { r.SnapshotDeleted = true }), Error: "NotRollbackable"},
+		{Name: "original pv deleting", Record: completed, Kept: with(func(r *util.ResizeRecord) { r.OriginalPVDeleting = true }), Error: "NotRollbackable"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			env := newRollbackEnv(&tt.Record, &tt.Kept)
+
+			env.ExecuteWorkflow(workflows.RollbackWorkflow, &proto.Rollback{WorkflowId: "pvscope/db/data-db-0", Source: tt.Source})
+			require.True(t, env.IsWorkflowCompleted())
+
+			if tt.Error != "" {
+				require.Equal(t, tt.Error, applicationErrorType(env.GetWorkflowError()))
+				env.AssertActivityNotCalled(t, "ScaleTo0", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, env.GetWorkflowError())
+			env.AssertActivityCalled(t, "RebindPV", mock.Anything, "db", tt.RestoredPV, mock.Anything, "10Gi")
+			env.AssertActivityCalled(t, "SetReclaimPolicy", mock.Anything, tt.RestoredPV, corev1.PersistentVolumeReclaimDelete)
+			// the resized volume is left retained in case the rollback was a mistake
+			env.AssertActivityCalled(t, "EnsureReclaimPolicyRetain", mock.Anything, "pv-staging")
+			env.AssertActivityNotCalled(t, "SetReclaimPolicy", mock.Anything, "pv-staging", mock.Anything)
+		})
+	}
+}
@@ -1,129 +1,597 @@
 package workflows
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
 	"github.com/aaronshifman/down-pvscope/pkg/activities"
 	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 const TaskQueueName = "down-pvscope"
 
+var defaultActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		MaximumInterval:    time.Minute,
+		BackoffCoefficient: 2,
+		MaximumAttempts:    5,
+	},
+}
+
+// withJobOptions is for activities that wait on an rclone job - they heartbeat while it runs
+func withJobOptions(ctx workflow.Context) workflow.Context {
+	return withJobTimeout(ctx, activities.JobTimeout)
+}
+
+// withJobTimeout is withJobOptions for a job allowed to run for `timeout`
+func withJobTimeout(ctx workflow.Context, timeout time.Duration) workflow.Context {
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = timeout + activities.JobTimeoutMargin
+	ao.HeartbeatTimeout = time.Minute
+	return workflow.WithActivityOptions(ctx, ao)
+}
+
+// ScaleDownWorkflow takes v1 Scale payloads and runs them as a ScaleWorkflow
+// resizes started by an older worker finish the way they started
+func ScaleDownWorkflow(ctx workflow.Context, input *proto.Scale) (*proto.ScaleResult, error) {
+	if workflow.GetVersion(ctx, scaleRequestChange, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		return nil, legacyScaleDown(ctx, input)
+	}
+	return ScaleWorkflow(ctx, util.ScaleRequestFromV1(input))
+}
+
+// ScaleWorkflow moves the pvc of a statefulset onto a smaller pv
+// a cancelled resize undoes whatever it got to before it stops
+// its steps are replayed for every running resize - a change to them needs a workflow.GetVersion gate
 // nolint: funlen
-func ScaleDownWorkflow(ctx workflow.Context, input *proto.Scale) error {
+func ScaleWorkflow(ctx workflow.Context, req *protov2.ScaleRequest) (result *proto.ScaleResult, err error) {
 	logger := workflow.GetLogger(ctx)
-	logger.Info("Starting workflow", "namespace", input.Namespace, "newSize", input.Size, "pvcTarget", input.Pvc, "sts", input.Sts)
-	ao := workflow.ActivityOptions{
-		StartToCloseTimeout: time.Minute,
-		RetryPolicy: &temporal.RetryPolicy{
-			InitialInterval:    time.Second,
-			MaximumInterval:    time.Minute,
-			BackoffCoefficient: 2,
-			MaximumAttempts:    5,
-		},
-	}
-	ctx = workflow.WithActivityOptions(ctx, ao)
+	err = util.ValidateScaleRequest(req)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidRequest", err)
+	}
+
+	ns, stsName := req.Workload.Namespace, req.Workload.Statefulset
+	pvcName, size := req.Target.Pvc, req.Target.Size
+	safety := req.GetOptions().GetSafety()
+	retention := req.GetOptions().GetRetention()
+	timeouts := req.GetOptions().GetTimeouts()
+	logger.Info("Starting workflow", "namespace", ns, "newSize", size, "pvcTarget", pvcName, "sts", stsName, "dryRun", req.DryRun)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	audit, err := newAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// everything a later rollback needs to put the original volume back
+	record := &util.ResizeRecord{Namespace: ns, StatefulSet: stsName, NewSize: size}
+	err = workflow.SetQueryHandler(ctx, util.RecordQuery, func() (*util.ResizeRecord, error) {
+		return record, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pause, err := newPauser(ctx, audit, safety.GetBreakpoints())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := newStatusTracker(ctx, pause)
+	if err != nil {
+		return nil, err
+	}
+
+	// cleanup undoes what the resize has done so far - it moves along with the resize and runs if it's cancelled
+	var cleanup func(ctx workflow.Context) error
+	defer func() {
+		if cleanup == nil || ctx.Err() == nil {
+			return
+		}
+		// the workflow's context is cancelled so the cleanup needs one of its own
+		cleanupCtx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		logger.Info("Resize cancelled - cleaning up", "step", status.Step)
+		audit.record(cleanupCtx, "cancelled", fmt.Sprintf("resize cancelled during %s - cleaning up", status.Step))
+		cleanupErr := cleanup(cleanupCtx)
+		if cleanupErr != nil {
+			result, err = nil, errors.Wrapf(cleanupErr, "Unable to clean up after the resize was cancelled during %s", status.Step)
+		}
+	}()
+
 	var pvca *activities.PVCActivities
 	var pva *activities.PVActivities
-	var ja *activities.JobActivities
 	var sts *activities.STSActivities
+	var ha *activities.HookActivities
 
 	// get original PVC
-	logger.Info("Getting the original PVC", "pvc", input.Pvc, "namespace", input.Namespace)
+	logger.Info("Getting the original PVC", "pvc", pvcName, "namespace", ns)
 	originalPVC := util.PvcInfo{}
-	err := workflow.ExecuteActivity(ctx, pvca.GetPVC, input.Namespace, input.Pvc).Get(ctx, &originalPVC)
+	err = workflow.ExecuteActivity(ctx, pvca.GetPVC, ns, pvcName).Get(ctx, &originalPVC)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	status.OriginalPvc = originalPVC.Name
+	status.OriginalPv = originalPVC.VolumeName
 	logger.Debug("Original pvc", "volume", originalPVC.VolumeName, "name", originalPVC.Namespace, "originalStorage", originalPVC.RequestedStorage)
+	err = util.CheckShrink(originalPVC.RequestedStorage, size)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidRequest", err)
+	}
+
+	// scaling to 0 bypasses the eviction api so check the PDBs ourselves before changing anything
+	logger.Info("Checking pod disruption budgets", "sts", stsName)
+	var violations []string
+	err = workflow.ExecuteActivity(ctx, sts.CheckDisruptionBudgets, ns, stsName).Get(ctx, &violations)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		if !safety.GetAllowDisruption() {
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("scaling %s to 0 violates disruption budgets: %s", stsName, strings.Join(violations, "; ")),
+				"DisruptionBudgetViolation",
+				nil,
+			)
+		}
+		for _, violation := range violations {
+			audit.warn(ctx, "disruption-budget-override", violation)
+		}
+	}
+
+	// resolve the hooks up front so a bad annotation fails before anything is touched
+	hookAnnotations := map[string]string{}
+	err = workflow.ExecuteActivity(ctx, ha.GetHookAnnotations, ns, stsName).Get(ctx, &hookAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	preHooks, err := resolveHooks(req.GetOptions().GetHooks().GetPreQuiesce(), hookAnnotations, util.PreHookAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	postHooks, err := resolveHooks(req.GetOptions().GetHooks().GetPostResume(), hookAnnotations, util.PostHookAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	if hookTimeout := timeouts.GetHook(); hookTimeout != nil {
+		preHooks = withHookTimeout(preHooks, hookTimeout)
+		postHooks = withHookTimeout(postHooks, hookTimeout)
+	}
+
+	var na *activities.NamespaceActivities
+	windowAnnotations := map[string]string{}
+	err = workflow.ExecuteActivity(ctx, na.GetWindowAnnotations, ns).Get(ctx, &windowAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	window, err := resolveWindow(safety.GetMaintenanceWindow(), windowAnnotations)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		result, err := planResize(ctx, req, audit, record, originalPVC, preHooks, postHooks, window)
+		if err != nil {
+			return nil, err
+		}
+		status.step(ctx, "done")
+		return result, nil
+	}
+
+	// the estimate also decides whether there is enough of the maintenance window left
+	precopy := req.GetOptions().GetMover().GetCopyMode() == proto.CopyMode_COPY_MODE_SNAPSHOT_PRECOPY
+	maxDowntime := safety.GetMaxDowntime().AsDuration()
+	copyTimeout := activities.JobTimeout
+	if timeouts.GetCopy() != nil {
+		copyTimeout = timeouts.GetCopy().AsDuration()
+	}
+	record.CopyTimeout = copyTimeout
+	if maxDowntime > 0 || window != nil {
+		record.EstimatedDowntime, err = estimateDowntime(ctx, audit, originalPVC, maxDowntime, precopy)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// mark existing pv safe (retain)
+	status.step(ctx, "provision-staging")
 	logger.Info("Marging the original pv retain", "pv", originalPVC.VolumeName)
 	var originalRetentionPolicy corev1.PersistentVolumeReclaimPolicy
 	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, originalPVC.VolumeName).Get(ctx, &originalRetentionPolicy)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("pv retention", "original", originalRetentionPolicy)
+	record.OriginalPVC = originalPVC
+	record.OriginalReclaimPolicy = originalRetentionPolicy
+	cleanup = func(ctx workflow.Context) error {
+		return workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, originalPVC.VolumeName, originalRetentionPolicy).Get(ctx, nil)
+	}
 
 	// create new PVC / provision new PV
-	logger.Info("Provisioning PVC of new size", "newSize", input.Size)
+	logger.Info("Provisioning PVC of new size", "newSize", size)
 	newPVC := util.PvcInfo{}
-	err = workflow.ExecuteActivity(ctx, pvca.CreateStagingPVC, originalPVC, input.Size).Get(ctx, &newPVC)
+	err = workflow.ExecuteActivity(ctx, pvca.CreateStagingPVC, originalPVC, size).Get(ctx, &newPVC)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("New pvc", "name", newPVC.Name, "size", newPVC.RequestedStorage, "volume", newPVC.VolumeName)
+	cleanup = func(ctx workflow.Context) error {
+		err := workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, originalPVC.VolumeName, originalRetentionPolicy).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+		// the staging pv is still on its own policy and goes with its claim
+		return workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, newPVC.Name).Get(ctx, nil)
+	}
+	record.NewPV = newPVC.VolumeName
+	status.StagingPvc = newPVC.Name
+	status.StagingPv = newPVC.VolumeName
 
 	// make sure new PV is safe
 	logger.Info("Ensuring that new PV is retain")
-	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, newPVC.VolumeName).Get(ctx, nil)
+	var newRetentionPolicy corev1.PersistentVolumeReclaimPolicy
+	err = workflow.ExecuteActivity(ctx, pva.EnsureReclaimPolicyRetain, newPVC.VolumeName).Get(ctx, &newRetentionPolicy)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	cleanup = func(ctx workflow.Context) error {
+		return releaseStaging(ctx, ns, originalPVC.VolumeName, originalRetentionPolicy, newPVC, newRetentionPolicy)
+	}
+
+	// the bulk of the data moves while the app is still up - the copy at 0 replicas only syncs what changed since
+	if precopy {
+		status.step(ctx, "precopy")
+		logger.Info("Pre-copying from a snapshot of the running PVC", "pvc", originalPVC.Name)
+		err = copyFromSnapshot(ctx, originalPVC, newPVC, req.GetOptions().GetMover().GetPrecopySnapshotClass(), copyTimeout)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// getting initial starting point for replicas
-	logger.Info("Getting starting point for replicas", "sts", input.Sts)
-	var initialReplicas int64
-	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, input.Namespace, input.Sts).Get(ctx, &initialReplicas)
+	logger.Info("Getting starting point for replicas", "sts", stsName)
+	var initialReplicas int32
+	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, ns, stsName).Get(ctx, &initialReplicas)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("Found replicas", "count", initialReplicas)
+	record.InitialReplicas = initialReplicas
+	status.InitialReplicas = initialReplicas
+
+	// everything up to here is safe to do at any time
+	if safety.GetApproval().GetRequired() {
+		plan := &util.ResizePlan{
+			Namespace:         ns,
+			StatefulSet:       stsName,
+			Replicas:          initialReplicas,
+			PVC:               originalPVC.Name,
+			OriginalPV:        originalPVC.VolumeName,
+			CurrentSize:       originalPVC.RequestedStorage,
+			NewSize:           size,
+			StagingPVC:        newPVC.Name,
+			NewPV:             newPVC.VolumeName,
+			EstimatedDowntime: record.EstimatedDowntime,
+			PreQuiesceHooks:   hookNames(preHooks),
+			PostResumeHooks:   hookNames(postHooks),
+		}
+		status.step(ctx, "approval")
+		pending := &proto.PendingApproval{RequestedAt: timestamppb.New(workflow.Now(ctx))}
+		if timeout := safety.GetApproval().GetTimeout().AsDuration(); timeout > 0 {
+			pending.ExpiresAt = timestamppb.New(workflow.Now(ctx).Add(timeout))
+		}
+		status.PendingApproval = pending
+		decision, err := awaitApproval(ctx, audit, plan, safety.GetApproval())
+		if err != nil {
+			return nil, err
+		}
+		status.PendingApproval = nil
+		record.Approver = decision.Approver
+		record.ApprovalReason = decision.Reason
+		if !decision.Approved {
+			err = releaseStaging(ctx, ns, originalPVC.VolumeName, originalRetentionPolicy, newPVC, newRetentionPolicy)
+			if err != nil {
+				return nil, errors.Wrap(err, "Unable to clean up after the plan was rejected")
+			}
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("plan rejected by %s: %s", decision.Approver, decision.Reason),
+				"Rejected",
+				nil,
+			)
+		}
+	}
+
+	if window != nil {
+		status.step(ctx, "maintenance-window")
+		err = waitForWindow(ctx, audit, window, record.EstimatedDowntime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status.step(ctx, "pre-quiesce-hooks")
+	logger.Info("Running pre-quiesce hooks", "sts", stsName, "count", len(preHooks))
+	err = runHooks(ctx, audit, "pre-quiesce", ns, stsName, preHooks)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last stop before scale-down - the window is open and the pre-quiesce hooks have run
+	err = pause.checkpoint(ctx, util.BeforeScaleDown)
+	if err != nil {
+		return nil, err
+	}
 
 	// scaling sts to 0
 	// TODO: ensure all other pvcs aren't nuked on scale down
-	logger.Info("Scaling sts to 0", "sts", input.Sts)
-	err = workflow.ExecuteActivity(ctx, sts.ScaleTo0, input.Namespace, input.Sts).Get(ctx, nil)
+	status.step(ctx, "scale-down")
+	// until the original pvc goes the sts can always go back to it
+	cleanup = func(ctx workflow.Context) error {
+		return abortBeforeCutover(ctx, record, newPVC, newRetentionPolicy, initialReplicas)
+	}
+	logger.Info("Scaling sts to 0", "sts", stsName)
+	err = workflow.ExecuteActivity(ctx, sts.ScaleTo0, ns, stsName).Get(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	zeroAt := workflow.Now(ctx)
+
+	if retention.GetSnapshot().GetEnabled() {
+		status.step(ctx, "snapshot")
+		record.Snapshot = snapshotName(ctx, originalPVC.Name, "safety")
+		err = takeSnapshot(ctx, ns, originalPVC.Name, record.Snapshot, retention.GetSnapshot().GetVolumeSnapshotClass())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if retention.GetBackup().GetEnabled() {
+		status.step(ctx, "backup")
+		backup, err := backupInfo(ctx, ns, originalPVC.Name, retention.GetBackup())
+		if err != nil {
+			return nil, err
+		}
+		// known before it's written so a cancelled backup is cleaned up too
+		record.Backup = &backup
+		backup, err = backupPVC(ctx, originalPVC, backup, copyTimeout)
+		if err != nil {
+			return nil, err
+		}
+		record.Backup = &backup
 	}
 
-	// TODO: figure out scale down then rclone or other way around
+	// after a pre-copy rclone only transfers what changed since the snapshot
+	status.step(ctx, "copy")
 	logger.Info("Creating RClone job", "originalPVC", originalPVC.Name, "newPVC", newPVC.Name, "originalSize", originalPVC.RequestedStorage, "newSize", newPVC.RequestedStorage)
-	err = workflow.ExecuteActivity(ctx, ja.Runrclone, originalPVC, newPVC, input.Namespace).Get(ctx, nil)
+	moverStats, err := copyWithinBudget(ctx, originalPVC, newPVC, ns, zeroAt, maxDowntime, copyTimeout)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// nothing has been deleted yet so running over the budget can still be undone
+	if moverStats == nil {
+		downFor := workflow.Now(ctx).Sub(zeroAt)
+		audit.record(ctx, "downtime-exceeded", fmt.Sprintf("sts %s at 0 replicas for %s of a %s budget - aborting before cutover", stsName, downFor, maxDowntime))
+		err = abortBeforeCutover(ctx, record, newPVC, newRetentionPolicy, initialReplicas)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to restore the sts after exceeding the downtime budget")
+		}
+		record.Downtime = workflow.Now(ctx).Sub(zeroAt)
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("copy did not finish within max_downtime %s - original pvc left in place", maxDowntime),
+			"DowntimeExceeded",
+			nil,
+		)
+	}
+
+	err = pause.checkpoint(ctx, util.BeforeDeleteOriginal)
+	if err != nil {
+		return nil, err
+	}
+
+	// once the original pvc starts going the way back is rebinding it to its retained pv
+	cleanup = func(ctx workflow.Context) error {
+		err := rollbackToOriginal(ctx, ns, stsName, originalPVC, originalRetentionPolicy, initialReplicas)
+		if err != nil {
+			return err
+		}
+		// the new pv stays retained like after any other rollback
+		return workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, newPVC.Name).Get(ctx, nil)
 	}
 
 	// drop both pvs
+	status.step(ctx, "delete-original")
 	logger.Info("Dropping pvc", "pvc", originalPVC.Name)
-	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, input.Namespace, originalPVC.Name).Get(ctx, nil)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, originalPVC.Name).Get(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logger.Info("Dropping pvc", "pvc", newPVC.Name)
-	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, input.Namespace, newPVC.Name).Get(ctx, nil)
+	err = workflow.ExecuteActivity(ctx, pvca.DeletePVC, ns, newPVC.Name).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pause.checkpoint(ctx, util.BeforeRebind)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// map the new pv to the original pvc
-	logger.Info("Rebinding original PVC name to new PV", "newPV", newPVC.VolumeName, "originalPVC", originalPVC.Name, "newSize", input.Size)
-	err = workflow.ExecuteActivity(ctx, pvca.RebindPV, input.Namespace, newPVC.VolumeName, originalPVC, input.Size).Get(ctx, nil)
+	status.step(ctx, "rebind")
+	logger.Info("Rebinding original PVC name to new PV", "newPV", newPVC.VolumeName, "originalPVC", originalPVC.Name, "newSize", size)
+	err = workflow.ExecuteActivity(ctx, pvca.RebindPV, ns, newPVC.VolumeName, originalPVC, size).Get(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	err = pause.checkpoint(ctx, util.BeforeScaleUp)
+	if err != nil {
+		return nil, err
+	}
+
+	// from here on the claim is on the new pv, which stays retained until the workload is verified on it
+	// so whatever goes wrong can still be undone by going back to the original pv
+	rollBack := func(event, reason string, cause error) error {
+		audit.record(ctx, event, cause.Error())
+		logger.Error("Rolling back to the original PV", "reason", reason, "pv", originalPVC.VolumeName, "error", cause)
+		rollbackErr := rollbackToOriginal(ctx, ns, stsName, originalPVC, originalRetentionPolicy, initialReplicas)
+		if rollbackErr != nil {
+			return errors.Wrapf(rollbackErr, "Unable to roll back after %s", reason)
+		}
+		audit.record(ctx, "rolled-back", fmt.Sprintf("claim %s rebound to original pv %s, new pv %s left retained", originalPVC.Name, originalPVC.VolumeName, newPVC.VolumeName))
+		return temporal.NewNonRetryableApplicationError(reason+" - rolled back to the original pv", "RolledBack", cause)
+	}
+
+	status.step(ctx, "scale-up")
+	err = scaleUp(ctx, ns, stsName, initialReplicas)
+	record.Downtime = workflow.Now(ctx).Sub(zeroAt)
+	if err != nil {
+		return nil, rollBack("scale-up-failed", "sts never became ready", err)
+	}
+	// past the point of no return an overrun can only be reported
+	if maxDowntime > 0 && record.Downtime > maxDowntime {
+		audit.warn(ctx, "downtime-exceeded", fmt.Sprintf("sts %s was at 0 replicas for %s of a %s budget", stsName, record.Downtime, maxDowntime))
+	}
+
+	status.step(ctx, "post-resume-hooks")
+	logger.Info("Running post-resume hooks", "sts", stsName, "count", len(postHooks))
+	err = runHooks(ctx, audit, "post-resume", ns, stsName, postHooks)
+	if err != nil {
+		// an aborting hook after the cutover says the workload isn't fine on the new volume
+		return nil, rollBack("post-resume-hook-failed", "post-resume hook failed", err)
+	}
+
+	status.step(ctx, "verify")
+	logger.Info("Verifying resumed sts", "sts", stsName)
+	err = verifyResumed(ctx, audit, ns, stsName, initialReplicas, req.GetOptions().GetVerification())
+	if err != nil {
+		return nil, rollBack("verification-failed", "verification failed", err)
 	}
 
 	logger.Info("Resetting reclaim policy on new PV", "pv", newPVC.VolumeName, "originalPolicy", originalRetentionPolicy)
 	err = workflow.ExecuteActivity(ctx, pva.SetReclaimPolicy, newPVC.VolumeName, originalRetentionPolicy).Get(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	record.Completed = true
+	// the resize is done - cancelling it from here on only stops the original pv from being deleted
+	cleanup = nil
 
-	logger.Info("Rescaling sts", "sts", input.Sts)
-	err = workflow.ExecuteActivity(ctx, sts.ScaleUp, input.Namespace, input.Sts, initialReplicas).Get(ctx, nil)
+	if record.Snapshot != "" && retention.GetSnapshot().GetRetention() == proto.SnapshotRetention_SNAPSHOT_RETENTION_DELETE_ON_SUCCESS {
+		err = deleteSnapshot(ctx, ns, record.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		record.SnapshotDeleted = true
+	}
+
+	// the result is persisted as soon as the resize is done rather than days later after the retention period,
+	// and again once the original pv's fate is known
+	persistResult := func() (*proto.ScaleResult, error) {
+		result, err := scaleResult(ctx, req.GetOptions().GetVerification(), record, moverStats, audit.warnings)
+		if err != nil {
+			return nil, err
+		}
+		if retention.GetPersistResult() != proto.ResultSink_RESULT_SINK_NONE {
+			var ra *activities.ResultActivities
+			name := fmt.Sprintf("%s-pvscope-%s", originalPVC.Name, workflow.GetInfo(ctx).WorkflowExecution.RunID[:8])
+			err = workflow.ExecuteActivity(ctx, ra.PersistResult, retention.GetPersistResult(), name, result).Get(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+	result, err = persistResult()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// TODO: optionally drop the original pv
+	if retainFor := retention.GetRetainOriginalFor().AsDuration(); retainFor > 0 {
+		status.step(ctx, "retain-original")
+		err = retainOriginalPV(ctx, audit, record, retainFor)
+		if err != nil {
+			return nil, err
+		}
+
+		if record.OriginalPVDeleted && record.Snapshot != "" && retention.GetSnapshot().GetRetention() == proto.SnapshotRetention_SNAPSHOT_RETENTION_WITH_ORIGINAL {
+			err = deleteSnapshot(ctx, ns, record.Snapshot)
+			if err != nil {
+				return nil, err
+			}
+			record.SnapshotDeleted = true
+		}
 
+		result, err = persistResult()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status.step(ctx, "done")
 	logger.Info("Workflow done")
-	return nil
+	return result, nil
+}
+
+// scaleResult summarizes a finished resize from its record
+func scaleResult(ctx workflow.Context, verification *proto.Verification, record *util.ResizeRecord, mover *proto.CopyProgress, warnings []string) (*proto.ScaleResult, error) {
+	oldSize, err := resource.ParseQuantity(record.OriginalPVC.RequestedStorage)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to parse original size")
+	}
+	newSize, err := resource.ParseQuantity(record.NewSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to parse new size")
+	}
+
+	info := workflow.GetInfo(ctx)
+	result := &proto.ScaleResult{
+		Namespace:         record.Namespace,
+		Pvc:               record.OriginalPVC.Name,
+		Sts:               record.StatefulSet,
+		OldSize:           record.OriginalPVC.RequestedStorage,
+		NewSize:           record.NewSize,
+		OldPv:             record.OriginalPVC.VolumeName,
+		NewPv:             record.NewPV,
+		OriginalPvDeleted: record.OriginalPVDeleted,
+		StorageClass:      record.OriginalPVC.StorageClassName,
+		ReclaimedBytes:    oldSize.Value() - newSize.Value(),
+		Mover:             mover,
+		Verification:      &proto.VerificationSummary{},
+		Downtime:          durationpb.New(record.Downtime),
+		Snapshot:          record.Snapshot,
+		Approver:          record.Approver,
+		Warnings:          warnings,
+		StartedAt:         timestamppb.New(info.WorkflowStartTime),
+		FinishedAt:        timestamppb.New(workflow.Now(ctx)),
+		WorkflowId:        info.WorkflowExecution.ID,
+	}
+	if record.EstimatedDowntime > 0 {
+		result.EstimatedDowntime = durationpb.New(record.EstimatedDowntime)
+	}
+	if record.Backup != nil {
+		result.BackupManifest = record.Backup.Manifest
+	}
+	if verification != nil {
+		result.Verification.Verified = true
+		result.Verification.StableFor = verification.StableFor
+		if verification.Probe != nil {
+			result.Verification.Probe = util.HookName(verification.Probe)
+		}
+	}
+	return result, nil
 }
@@ -0,0 +1,329 @@
+package workflows_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	protov2 "github.com/aaronshifman/down-pvscope/api/down-pvscope/v2"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/aaronshifman/down-pvscope/pkg/workflows"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// newResizeEnv mocks every activity of resizing data-db-0 of sts db from 10Gi to 5Gi
+// overrides are mocked ahead of the defaults so they're matched first
+func newResizeEnv(overrides ...func(env *testsuite.TestWorkflowEnvironment)) *testsuite.TestWorkflowEnvironment {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	for _, override := range overrides {
+		override(env)
+	}
+
+	var pvca *activities.PVCActivities
+	var pva *activities.PVActivities
+	var ja *activities.JobActivities
+	var sts *activities.STSActivities
+	var ha *activities.HookActivities
+	var na *activities.NamespaceActivities
+	env.OnActivity(pvca.GetPVC, mock.Anything, "db", "data-db-0").Return(&original, nil)
+	env.OnActivity(sts.CheckDisruptionBudgets, mock.Anything, "db", "db").Return([]string{}, nil)
+	env.OnActivity(ha.GetHookAnnotations, mock.Anything, "db", "db").Return(map[string]string{}, nil)
+	env.OnActivity(na.GetWindowAnnotations, mock.Anything, "db").Return(map[string]string{}, nil)
+	env.OnActivity(pva.EnsureReclaimPolicyRetain, mock.Anything, mock.Anything).Return(corev1.PersistentVolumeReclaimDelete, nil)
+	env.OnActivity(pvca.CreateStagingPVC, mock.Anything, mock.Anything, "5Gi").Return(&staging, nil)
+	env.OnActivity(sts.GetInitialReplicase, mock.Anything, "db", "db").Return(int32(3), nil)
+	env.OnActivity(sts.ScaleTo0, mock.Anything, "db", "db").Return(nil)
+	env.OnActivity(ja.Runrclone, mock.Anything, mock.Anything, mock.Anything, "db").Return(&proto.CopyProgress{Job: "data-db-0-rclone", Bytes: 1 << 30}, nil)
+	env.OnActivity(pvca.DeletePVC, mock.Anything, "db", mock.Anything).Return(nil)
+	env.OnActivity(pvca.RebindPV, mock.Anything, "db", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(sts.SetReplicas, mock.Anything, "db", "db", int32(3)).Return(nil)
+	env.OnActivity(sts.WaitReady, mock.Anything, "db", "db", int32(3)).Return(nil)
+	env.OnActivity(pva.SetReclaimPolicy, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	return env
+}
+
+func scaleInput() *proto.Scale {
+	return &proto.Scale{Namespace: "db", Pvc: "data-db-0", Sts: "db", Size: "5Gi"}
+}
+
+// applicationErrorType is the type of the ApplicationError a workflow failed with
+func applicationErrorType(err error) string {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return appErr.Type()
+	}
+	return ""
+}
+
+func TestScaleDownWorkflow(t *testing.T) {
+	env := newResizeEnv()
+	env.ExecuteWorkflow(workflows.ScaleDownWorkflow, scaleInput())
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	result := &proto.ScaleResult{}
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, "pv-original", result.OldPv)
+	require.Equal(t, "pv-staging", result.NewPv)
+	require.EqualValues(t, 5<<30, result.ReclaimedBytes)
+	require.Equal(t, "data-db-0-rclone", result.Mover.GetJob())
+	env.AssertActivityCalled(t, "RebindPV", mock.Anything, "db", "pv-staging", mock.Anything, "5Gi")
+	env.AssertActivityCalled(t, "SetReclaimPolicy", mock.Anything, "pv-staging", corev1.PersistentVolumeReclaimDelete)
+	env.AssertActivityNotCalled(t, "DeletePV", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScaleDownWorkflowApproval(t *testing.T) {
+	approval := func(approved bool) func(env *testsuite.TestWorkflowEnvironment) {
+		return func(env *testsuite.TestWorkflowEnvironment) {
+			env.SignalWorkflow(util.ApprovalUpdate, &proto.ApprovalDecision{Approved: approved, Approver: "ops", Reason: "weekly rightsizing"})
+		}
+	}
+
+	testCases := []struct {
+		Name string
+		// Decide runs once the resize has been waiting an hour
+		Decide   func(env *testsuite.TestWorkflowEnvironment)
+		Approver string
+		Error    string
+	}{
+		{Name: "approved", Decide: approval(true), Approver: "ops"},
+		{Name: "rejected", Decide: approval(false), Error: "Rejected"},
+		{Name: "undecided", Error: "Rejected"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			env := newResizeEnv()
+			input := scaleInput()
+			input.Approval = &proto.Approval{Required: true}
+			if tt.Decide != nil {
+				env.RegisterDelayedCallback(func() { tt.Decide(env) }, time.Hour)
+			} else {
+				input.Approval.Timeout = durationpb.New(30 * time.Minute)
+			}
+
+			env.ExecuteWorkflow(workflows.ScaleDownWorkflow, input)
+			require.True(t, env.IsWorkflowCompleted())
+
+			if tt.Error != "" {
+				require.Equal(t, tt.Error, applicationErrorType(env.GetWorkflowError()))
+				env.AssertActivityNotCalled(t, "ScaleTo0", mock.Anything, mock.Anything, mock.Anything)
+				// the staging pvc is released and the original pv goes back to its own policy
+				env.AssertActivityCalled(t, "DeletePVC", mock.Anything, "db", staging.Name)
+				env.AssertActivityCalled(t, "SetReclaimPolicy", mock.Anything, original.VolumeName, corev1.PersistentVolumeReclaimDelete)
+				return
+			}
+			require.NoError(t, env.GetWorkflowError())
+			result := &proto.ScaleResult{}
+			require.NoError(t, env.GetWorkflowResult(&result))
+			require.Equal(t, tt.Approver, result.Approver)
+			env.AssertActivityCalled(t, "ScaleTo0", mock.Anything, "db", "db")
+		})
+	}
+}
+
+func TestScaleDownWorkflowPause(t *testing.T) {
+	testCases := []struct {
+		Name string
+		// Then runs while the resize is parked
+		Then      func(env *testsuite.TestWorkflowEnvironment)
+		Cancelled bool
+	}{
+		{Name: "resumed", Then: func(env *testsuite.TestWorkflowEnvironment) { env.SignalWorkflow(util.ResumeSignal, nil) }},
+		{Name: "cancelled", Then: func(env *testsuite.TestWorkflowEnvironment) { env.CancelWorkflow() }, Cancelled: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			env := newResizeEnv()
+			input := scaleInput()
+			input.Breakpoints = []string{util.BeforeDeleteOriginal}
+
+			var parked *util.Parked
+			env.RegisterDelayedCallback(func() {
+				value, err := env.QueryWorkflow(util.ParkedQuery)
+				require.NoError(t, err)
+				require.NoError(t, value.Get(&parked))
+				tt.Then(env)
+			}, time.Hour)
+
+			env.ExecuteWorkflow(workflows.ScaleDownWorkflow, input)
+			require.True(t, env.IsWorkflowCompleted())
+			require.NotNil(t, parked)
+			require.Equal(t, util.BeforeDeleteOriginal, parked.Breakpoint)
+
+			if !tt.Cancelled {
+				require.NoError(t, env.GetWorkflowError())
+				env.AssertActivityCalled(t, "DeletePVC", mock.Anything, "db", original.Name)
+				return
+			}
+			var cancelled *temporal.CanceledError
+			require.ErrorAs(t, env.GetWorkflowError(), &cancelled)
+			// nothing was deleted yet so the sts is scaled back up on the original pv and the staging pvc goes
+			env.AssertActivityNotCalled(t, "DeletePVC", mock.Anything, "db", original.Name)
+			env.AssertActivityNotCalled(t, "RebindPV", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			env.AssertActivityNumberOfCalls(t, "SetReplicas", 1)
+			env.AssertActivityCalled(t, "DeletePVC", mock.Anything, "db", staging.Name)
+		})
+	}
+}
+
+func TestScaleDownWorkflowPauseBeforeScaleDown(t *testing.T) {
+	var ha *activities.HookActivities
+	env := newResizeEnv()
+	env.OnActivity(ha.ListHookTargets, mock.Anything, "db", "db").Return([]string{"db-0"}, nil)
+	env.OnActivity(ha.RunHook, mock.Anything, "db", "db-0", mock.Anything).Return(&activities.HookResult{}, nil)
+	input := scaleInput()
+	input.Breakpoints = []string{util.BeforeScaleDown}
+	input.PreQuiesceHooks = []*proto.Hook{{Name: "flush", Command: []string{"sync"}}}
+
+	env.RegisterDelayedCallback(func() {
+		// parked right before scaling to 0, with the pre-quiesce hooks done
+		env.AssertActivityNumberOfCalls(t, "RunHook", 1)
+		env.AssertActivityNotCalled(t, "ScaleTo0", mock.Anything, mock.Anything, mock.Anything)
+		env.SignalWorkflow(util.ResumeSignal, nil)
+	}, time.Hour)
+
+	env.ExecuteWorkflow(workflows.ScaleDownWorkflow, input)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertActivityNumberOfCalls(t, "RunHook", 1)
+}
+
+func TestScaleDownWorkflowUnready(t *testing.T) {
+	var sts *activities.STSActivities
+	env := newResizeEnv(func(env *testsuite.TestWorkflowEnvironment) {
+		crashloop := temporal.NewNonRetryableApplicationError("pod db-0 is crashlooping", "VerificationFailed", nil)
+		env.OnActivity(sts.WaitReady, mock.Anything, "db", "db", int32(3)).Return(crashloop).Once()
+	})
+
+	env.ExecuteWorkflow(workflows.ScaleDownWorkflow, scaleInput())
+	require.True(t, env.IsWorkflowCompleted())
+	require.Equal(t, "RolledBack", applicationErrorType(env.GetWorkflowError()))
+	// the claim goes back to the original pv and the new one stays retained
+	env.AssertActivityCalled(t, "RebindPV", mock.Anything, "db", "pv-original", mock.Anything, "10Gi")
+	env.AssertActivityNotCalled(t, "SetReclaimPolicy", mock.Anything, "pv-staging", mock.Anything)
+	env.AssertActivityNumberOfCalls(t, "WaitReady", 2)
+}
+
+func TestScaleDownWorkflowRetention(t *testing.T) {
+	testCases := []struct {
+		Name string
+		// During runs while the original pv is retained
+		During  func(env *testsuite.TestWorkflowEnvironment)
+		Deleted bool
+	}{
+		{Name: "expired", Deleted: true},
+		{Name: "kept", During: func(env *testsuite.TestWorkflowEnvironment) {
+			env.SignalWorkflow(util.OriginalPVSignal, util.OriginalPVKeep)
+		}},
+		{Name: "cancelled", During: func(env *testsuite.TestWorkflowEnvironment) { env.CancelWorkflow() }},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			var pva *activities.PVActivities
+			env := newResizeEnv()
+			env.OnActivity(pva.DeletePV, mock.Anything, "pv-original", corev1.PersistentVolumeReclaimDelete).Return(nil)
+			if tt.During != nil {
+				env.RegisterDelayedCallback(func() { tt.During(env) }, time.Hour)
+			}
+
+			input := scaleInput()
+			input.RetainOriginalFor = durationpb.New(24 * time.Hour)
+			env.ExecuteWorkflow(workflows.ScaleDownWorkflow, input)
+			require.True(t, env.IsWorkflowCompleted())
+			value, err := env.QueryWorkflow(util.RecordQuery)
+			require.NoError(t, err)
+			record := util.ResizeRecord{}
+			require.NoError(t, value.Get(&record))
+
+			if tt.Deleted {
+				require.NoError(t, env.GetWorkflowError())
+				require.True(t, record.OriginalPVDeleted)
+				env.AssertActivityCalled(t, "DeletePV", mock.Anything, "pv-original", corev1.PersistentVolumeReclaimDelete)
+				return
+			}
+			require.True(t, record.OriginalPVKept)
+			require.False(t, record.OriginalPVDeleting)
+			env.AssertActivityNotCalled(t, "DeletePV", mock.Anything, mock.Anything, mock.Anything)
+
+			// the kept pv can still be rolled back to
+			rollback := newRollbackEnv(&record, &record)
+			rollback.ExecuteWorkflow(workflows.RollbackWorkflow, &proto.Rollback{WorkflowId: "pvscope/db/data-db-0"})
+			require.True(t, rollback.IsWorkflowCompleted())
+			require.NoError(t, rollback.GetWorkflowError())
+			rollback.AssertActivityCalled(t, "RebindPV", mock.Anything, "db", "pv-original", mock.Anything, "10Gi")
+		})
+	}
+}
+
+func TestScaleDownWorkflowPrecopy(t *testing.T) {
+	var pvca *activities.PVCActivities
+	var sa *activities.SnapshotActivities
+	precopy := mock.MatchedBy(func(name string) bool { return strings.HasSuffix(name, "-precopy") })
+	restored := original
+	restored.Name = "data-db-0-snapshot-source"
+	restored.VolumeName = "pv-snapshot-source"
+	env := newResizeEnv()
+	env.OnActivity(sa.CreateSnapshot, mock.Anything, "db", precopy, "data-db-0", "csi-snapclass").Return(nil)
+	env.OnActivity(pvca.CreateRestorePVC, mock.Anything, original, restored.Name, precopy).Return(&restored, nil)
+	env.OnActivity(sa.DeleteSnapshot, mock.Anything, "db", precopy).Return(nil)
+
+	input := scaleInput()
+	input.CopyMode = proto.CopyMode_COPY_MODE_SNAPSHOT_PRECOPY
+	input.PrecopySnapshotClass = "csi-snapclass"
+	req := util.ScaleRequestFromV1(input)
+	req.Options.Timeouts = &protov2.Timeouts{Copy: durationpb.New(2 * time.Hour)}
+	env.ExecuteWorkflow(workflows.ScaleWorkflow, req)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	// the bulk copy reads the restored snapshot, for as long as the request allows copies to run,
+	// and only the delta is copied from the real volume
+	copyTimeout := mock.MatchedBy(func(ctx context.Context) bool {
+		deadline, ok := ctx.Deadline()
+		return ok && time.Until(deadline) > 2*time.Hour
+	})
+	env.AssertActivityCalled(t, "Runrclone", copyTimeout, &restored, &staging, "db")
+	env.AssertActivityCalled(t, "Runrclone", mock.Anything, &original, &staging, "db")
+	env.AssertActivityCalled(t, "DeletePVC", mock.Anything, "db", restored.Name)
+	env.AssertActivityCalled(t, "DeleteSnapshot", mock.Anything, "db", precopy)
+}
+
+func TestScaleDownWorkflowLegacy(t *testing.T) {
+	var sts *activities.STSActivities
+	env := newResizeEnv()
+	// a resize started by a worker from before ScaleWorkflow
+	env.OnGetVersion("scale-request", workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+	env.OnActivity(sts.ScaleUp, mock.Anything, "db", "db", int32(3)).Return(nil)
+
+	env.ExecuteWorkflow(workflows.ScaleDownWorkflow, scaleInput())
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertActivityNotCalled(t, "CheckDisruptionBudgets", mock.Anything, mock.Anything, mock.Anything)
+	// it still relies on ScaleUp waiting for the replicas to be ready
+	env.AssertActivityCalled(t, "ScaleUp", mock.Anything, "db", "db", int32(3))
+	env.AssertActivityNotCalled(t, "SetReplicas", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	env.AssertActivityNotCalled(t, "WaitReady", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// it can still be rolled back
+	value, err := env.QueryWorkflow(util.RecordQuery)
+	require.NoError(t, err)
+	record := util.ResizeRecord{}
+	require.NoError(t, value.Get(&record))
+	require.True(t, record.Completed)
+	require.Equal(t, original, record.OriginalPVC)
+	require.Equal(t, corev1.PersistentVolumeReclaimDelete, record.OriginalReclaimPolicy)
+	require.EqualValues(t, 3, record.InitialReplicas)
+}
@@ -0,0 +1,46 @@
+package workflows
+
+import (
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ScanWorkflow recommends which pvcs in the scanned namespaces are worth shrinking and to what size
+func ScanWorkflow(ctx workflow.Context, input *proto.Scan) (*proto.ScanReport, error) {
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	return runScan(ctx, input)
+}
+
+func runScan(ctx workflow.Context, input *proto.Scan) (*proto.ScanReport, error) {
+	logger := workflow.GetLogger(ctx)
+	var sa *activities.ScanActivities
+
+	if _, err := util.ScanLimits(input); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidScan", err)
+	}
+
+	var namespaces []string
+	err := workflow.ExecuteActivity(ctx, sa.ScanNamespaces, input).Get(ctx, &namespaces)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Scanning namespaces", "namespaces", namespaces)
+
+	// every node's kubelet is asked for its stats so this takes a while on large clusters
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = 10 * time.Minute
+	ao.HeartbeatTimeout = time.Minute
+	report := &proto.ScanReport{}
+	err = workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, ao), sa.Scan, input, namespaces).Get(ctx, &report)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Scan done", "scanned", report.Scanned, "recommendations", len(report.Recommendations), "unmeasured", len(report.Unmeasured))
+	return report, nil
+}
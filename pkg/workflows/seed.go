@@ -0,0 +1,127 @@
+package workflows
+
+import (
+	"fmt"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/k8s"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// SeedReplicasWorkflow pre-creates the claims of new sts ordinals filled with a copy of an existing replica's
+// volumes and only then scales the sts up, so the new replicas don't start empty
+// nolint: funlen
+func SeedReplicasWorkflow(ctx workflow.Context, input *proto.SeedReplicas) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting seed", "namespace", input.Namespace, "sts", input.Sts, "replicas", input.Replicas, "sourceOrdinal", input.SourceOrdinal, "source", input.Source)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	audit, err := newAuditLog(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pvca *activities.PVCActivities
+	var ja *activities.JobActivities
+	var sts *activities.STSActivities
+
+	var current int32
+	err = workflow.ExecuteActivity(ctx, sts.GetInitialReplicase, input.Namespace, input.Sts).Get(ctx, &current)
+	if err != nil {
+		return err
+	}
+	if input.Replicas <= current {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("sts %s already has %d replicas", input.Sts, current), "InvalidSeed", nil)
+	}
+	if input.SourceOrdinal < 0 || input.SourceOrdinal >= current {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("source ordinal %d isn't one of the %d running replicas", input.SourceOrdinal, current), "InvalidSeed", nil)
+	}
+
+	var templates []string
+	err = workflow.ExecuteActivity(ctx, sts.GetClaimTemplates, input.Namespace, input.Sts).Get(ctx, &templates)
+	if err != nil {
+		return err
+	}
+
+	owner := workflow.GetInfo(ctx).WorkflowExecution.ID
+	switch input.Source {
+	case proto.SeedSource_SEED_SOURCE_SNAPSHOT:
+		// restoring straight from the snapshot needs no copy at all
+		for _, template := range templates {
+			err = seedFromSnapshot(ctx, input, template, current, owner)
+			if err != nil {
+				return err
+			}
+		}
+	case proto.SeedSource_SEED_SOURCE_QUIESCE:
+		claims := map[string][]util.PvcInfo{}
+		for _, template := range templates {
+			for ordinal := current; ordinal < input.Replicas; ordinal++ {
+				claim := util.PvcInfo{}
+				err = workflow.ExecuteActivity(ctx, pvca.CreateReplicaPVC, input.Namespace, input.Sts, template, ordinal, "", owner).Get(ctx, &claim)
+				if err != nil {
+					return err
+				}
+				claims[template] = append(claims[template], claim)
+			}
+		}
+
+		err = quiesced(ctx, audit, input.Namespace, input.Sts, input.PreQuiesceHooks, input.PostResumeHooks, func() error {
+			for _, template := range templates {
+				source := util.PvcInfo{Name: k8s.ReplicaClaimName(template, input.Sts, input.SourceOrdinal)}
+				for _, claim := range claims[template] {
+					logger.Info("Creating RClone job", "source", source.Name, "dest", claim.Name)
+					err := workflow.ExecuteActivity(withJobOptions(ctx), ja.Runrclone, source, claim, input.Namespace).Get(ctx, nil)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	default:
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown seed source %s", input.Source), "InvalidSeed", nil)
+	}
+
+	logger.Info("Scaling sts up to seeded replicas", "sts", input.Sts, "replicas", input.Replicas)
+	err = scaleUp(ctx, input.Namespace, input.Sts, input.Replicas)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Seed done")
+	return nil
+}
+
+// seedFromSnapshot restores the template's claims of the new ordinals from one snapshot of the source replica
+// the snapshot is dropped afterwards, whether the claims were created or not
+func seedFromSnapshot(ctx workflow.Context, input *proto.SeedReplicas, template string, current int32, owner string) (err error) {
+	var pvca *activities.PVCActivities
+	source := k8s.ReplicaClaimName(template, input.Sts, input.SourceOrdinal)
+	snapshot := snapshotName(ctx, source, "seed")
+	defer func() {
+		cleanupCtx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		cleanupErr := deleteSnapshot(cleanupCtx, input.Namespace, snapshot)
+		if err == nil {
+			err = cleanupErr
+		}
+	}()
+
+	err = takeSnapshot(ctx, input.Namespace, source, snapshot, input.VolumeSnapshotClass)
+	if err != nil {
+		return err
+	}
+	for ordinal := current; ordinal < input.Replicas; ordinal++ {
+		err = workflow.ExecuteActivity(ctx, pvca.CreateReplicaPVC, input.Namespace, input.Sts, template, ordinal, snapshot, owner).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
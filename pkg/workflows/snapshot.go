@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/workflow"
+)
+
+const snapshotSourceSuffix = "-snapshot-source"
+
+// snapshotName is unique per run so a repeated resize of the same pvc never picks up an old snapshot
+// purpose tells apart the snapshots a single run takes of the same pvc
+func snapshotName(ctx workflow.Context, pvc, purpose string) string {
+	return fmt.Sprintf("%s-pvscope-%s-%s", pvc, workflow.GetInfo(ctx).WorkflowExecution.RunID[:8], purpose)
+}
+
+// takeSnapshot snapshots the pvc and waits for the snapshot to be ready to use
+func takeSnapshot(ctx workflow.Context, ns, pvc, name, class string) error {
+	var sa *activities.SnapshotActivities
+	workflow.GetLogger(ctx).Info("Snapshotting PVC", "pvc", pvc, "snapshot", name, "class", class)
+
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = activities.SnapshotReadyTimeout + time.Minute
+	sctx := workflow.WithActivityOptions(ctx, ao)
+	return workflow.ExecuteActivity(sctx, sa.CreateSnapshot, ns, name, pvc, class).Get(ctx, nil)
+}
+
+func deleteSnapshot(ctx workflow.Context, ns, name string) error {
+	var sa *activities.SnapshotActivities
+	workflow.GetLogger(ctx).Info("Deleting snapshot", "snapshot", name)
+
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = activities.SnapshotDeleteTimeout + time.Minute
+	dctx := workflow.WithActivityOptions(ctx, ao)
+	return workflow.ExecuteActivity(dctx, sa.DeleteSnapshot, ns, name).Get(ctx, nil)
+}
+
+// copyFromSnapshot copies a point in time view of the source into dest through a temporary restored volume
+// the snapshot and the temporary volume are removed afterwards, whether the copy worked or not
+// the copy job is allowed to run for `timeout`
+func copyFromSnapshot(ctx workflow.Context, source, dest util.PvcInfo, class string, timeout time.Duration) (err error) {
+	logger := workflow.GetLogger(ctx)
+	var pvca *activities.PVCActivities
+	var ja *activities.JobActivities
+
+	snapshot := snapshotName(ctx, source.Name, "precopy")
+	restoreName := source.Name + snapshotSourceSuffix
+	defer func() {
+		// a failed or cancelled copy must not leave the snapshot or its volume behind
+		cleanupCtx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		logger.Info("Dropping pvc", "pvc", restoreName)
+		cleanupErr := workflow.ExecuteActivity(cleanupCtx, pvca.DeletePVC, source.Namespace, restoreName).Get(cleanupCtx, nil)
+		if cleanupErr == nil {
+			cleanupErr = deleteSnapshot(cleanupCtx, source.Namespace, snapshot)
+		}
+		if err == nil {
+			err = cleanupErr
+		}
+	}()
+
+	err = takeSnapshot(ctx, source.Namespace, source.Name, snapshot, class)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Restoring snapshot", "snapshot", snapshot, "pvc", restoreName)
+	restored := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.CreateRestorePVC, source, restoreName, snapshot).Get(ctx, &restored)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Creating RClone job", "source", restored.Name, "dest", dest.Name)
+	return workflow.ExecuteActivity(withJobTimeout(ctx, timeout), ja.Runrclone, restored, dest, source.Namespace).Get(ctx, nil)
+}
@@ -0,0 +1,47 @@
+package workflows
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/workflow"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statusTracker keeps the ScaleStatus the status query returns
+type statusTracker struct {
+	*proto.ScaleStatus
+}
+
+// newStatusTracker exposes the status through the StatusQuery handler
+// copy progress isn't part of it - the copy activity heartbeats that
+func newStatusTracker(ctx workflow.Context, pause *pauser) (*statusTracker, error) {
+	t := &statusTracker{&proto.ScaleStatus{}}
+	t.step(ctx, "preflight")
+
+	err := workflow.SetQueryHandler(ctx, util.StatusQuery, func() (*proto.ScaleStatus, error) {
+		status := protobuf.Clone(t.ScaleStatus).(*proto.ScaleStatus)
+		if pause.parked != nil {
+			status.ParkedAt = pause.parked.Breakpoint
+		}
+		return status, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// step finishes the current step and starts the next one
+func (t *statusTracker) step(ctx workflow.Context, name string) {
+	now := timestamppb.New(workflow.Now(ctx))
+	if t.Step != "" {
+		t.CompletedSteps = append(t.CompletedSteps, &proto.StepTiming{
+			Name:       t.Step,
+			StartedAt:  t.StepStartedAt,
+			FinishedAt: now,
+		})
+	}
+	t.Step = name
+	t.StepStartedAt = now
+}
@@ -0,0 +1,108 @@
+package workflows
+
+import (
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ExportPVCWorkflow streams a pvc to object storage, quiescing its sts while it does
+// the result (spec + location) is everything ImportPVCWorkflow needs, in this cluster or another one
+func ExportPVCWorkflow(ctx workflow.Context, input *proto.Export) (*proto.ExportResult, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting export", "namespace", input.Namespace, "pvc", input.Pvc, "sts", input.Sts)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	audit, err := newAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Destination == nil {
+		return nil, temporal.NewNonRetryableApplicationError("export needs a destination", "InvalidExport", nil)
+	}
+
+	var pvca *activities.PVCActivities
+	logger.Info("Getting the PVC", "pvc", input.Pvc, "namespace", input.Namespace)
+	pvc := util.PvcInfo{}
+	err = workflow.ExecuteActivity(ctx, pvca.GetPVC, input.Namespace, input.Pvc).Get(ctx, &pvc)
+	if err != nil {
+		return nil, err
+	}
+
+	backup, err := backupInfo(ctx, input.Namespace, input.Pvc, input.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	export := func() error {
+		backup, err = backupPVC(ctx, pvc, backup, activities.JobTimeout)
+		return err
+	}
+	if input.Sts != "" {
+		err = quiesced(ctx, audit, input.Namespace, input.Sts, input.PreQuiesceHooks, input.PostResumeHooks, export)
+	} else {
+		err = export()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Export done", "manifest", backup.Manifest)
+	return &proto.ExportResult{
+		Pvc: pvc.ToProto(),
+		Location: &proto.Backup{
+			SecretName: backup.SecretName,
+			Bucket:     backup.Bucket,
+			Prefix:     backup.Prefix,
+		},
+		Manifest:       backup.Manifest,
+		ManifestSha256: backup.ManifestSHA256,
+	}, nil
+}
+
+// ImportPVCWorkflow provisions a new pvc from an exported spec and restores the exported data into it
+func ImportPVCWorkflow(ctx workflow.Context, input *proto.Import) (*proto.PvcSpec, error) {
+	logger := workflow.GetLogger(ctx)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	if input.Pvc == nil || input.Source == nil || input.Source.Bucket == "" || input.Source.Prefix == "" {
+		return nil, temporal.NewNonRetryableApplicationError("import needs a pvc spec and a source bucket and prefix", "InvalidImport", nil)
+	}
+
+	pvc := util.NewPVCInfoFromProto(input.Pvc)
+	if input.Namespace != "" {
+		pvc.Namespace = input.Namespace
+	}
+	if input.Name != "" {
+		pvc.Name = input.Name
+	}
+	if input.StorageClassName != "" {
+		pvc.StorageClassName = input.StorageClassName
+	}
+	if input.Size != "" {
+		pvc.RequestedStorage = input.Size
+		pvc.LimitStorage = input.Size
+	}
+	logger.Info("Starting import", "namespace", pvc.Namespace, "pvc", pvc.Name, "size", pvc.RequestedStorage, "bucket", input.Source.Bucket, "prefix", input.Source.Prefix)
+
+	var pvca *activities.PVCActivities
+	created := util.PvcInfo{}
+	err := workflow.ExecuteActivity(ctx, pvca.CreateImportPVC, pvc, workflow.GetInfo(ctx).WorkflowExecution.ID).Get(ctx, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	err = restoreBackup(ctx, created, util.BackupInfo{
+		SecretName:     input.Source.SecretName,
+		Bucket:         input.Source.Bucket,
+		Prefix:         input.Source.Prefix,
+		ManifestSHA256: input.ManifestSha256,
+	}, activities.JobTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to restore into %s/%s", created.Namespace, created.Name)
+	}
+
+	logger.Info("Import done", "pvc", created.Name, "pv", created.VolumeName)
+	return created.ToProto(), nil
+}
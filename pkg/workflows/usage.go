@@ -0,0 +1,45 @@
+package workflows
+
+import (
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// UsageCollectorWorkflow samples the used bytes of every mounted pvc in the namespaces into their usage history
+// it's meant to run on a schedule, scans project growth from the history it builds
+func UsageCollectorWorkflow(ctx workflow.Context, input *proto.UsageCollection) error {
+	logger := workflow.GetLogger(ctx)
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions)
+	var sa *activities.ScanActivities
+	var ua *activities.UsageActivities
+
+	if err := util.ValidateUsageCollection(input); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "InvalidUsageCollection", err)
+	}
+	retention := util.DefaultUsageRetention
+	if input.Retention != nil {
+		retention = input.Retention.AsDuration()
+	}
+
+	var namespaces []string
+	scan := &proto.Scan{Namespaces: input.Namespaces, NamespaceSelector: input.NamespaceSelector}
+	if err := workflow.ExecuteActivity(ctx, sa.ScanNamespaces, scan).Get(ctx, &namespaces); err != nil {
+		return err
+	}
+
+	// like a scan every node's kubelet is asked for its stats
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = 10 * time.Minute
+	ao.HeartbeatTimeout = time.Minute
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, ao), ua.CollectUsage, namespaces, retention).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	logger.Info("Collected usage", "namespaces", namespaces)
+	return nil
+}
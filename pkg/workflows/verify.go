@@ -0,0 +1,57 @@
+package workflows
+
+import (
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/activities"
+	"go.temporal.io/sdk/workflow"
+)
+
+// scaleUp gives the sts its replicas back and waits for them to be ready
+func scaleUp(ctx workflow.Context, ns, stsName string, replicas int32) error {
+	var sts *activities.STSActivities
+	workflow.GetLogger(ctx).Info("Rescaling sts", "sts", stsName, "replicas", replicas)
+	err := workflow.ExecuteActivity(ctx, sts.SetReplicas, ns, stsName, replicas).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return awaitReady(ctx, ns, stsName, replicas)
+}
+
+// awaitReady is the health check every resume gets: all replicas ready and none crashlooping
+func awaitReady(ctx workflow.Context, ns, stsName string, replicas int32) error {
+	var sts *activities.STSActivities
+	ao := defaultActivityOptions
+	ao.StartToCloseTimeout = activities.ReadyTimeout + time.Minute
+	ao.HeartbeatTimeout = time.Minute
+	return workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, ao), sts.WaitReady, ns, stsName, replicas).Get(ctx, nil)
+}
+
+// verifyResumed holds the resumed workload to the requested verification checks
+// any error means the workload can't be trusted on the new volume
+func verifyResumed(ctx workflow.Context, audit *auditLog, ns, stsName string, replicas int32, spec *proto.Verification) error {
+	if spec == nil {
+		return nil
+	}
+	logger := workflow.GetLogger(ctx)
+	var sts *activities.STSActivities
+
+	if stableFor := spec.StableFor.AsDuration(); stableFor > 0 {
+		logger.Info("Waiting for sts to stay healthy", "sts", stsName, "duration", stableFor)
+		ao := defaultActivityOptions
+		ao.StartToCloseTimeout = stableFor + 5*time.Minute
+		ao.HeartbeatTimeout = time.Minute
+		vctx := workflow.WithActivityOptions(ctx, ao)
+		err := workflow.ExecuteActivity(vctx, sts.WaitStable, ns, stsName, replicas, stableFor).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.Probe != nil {
+		logger.Info("Running verification probe", "sts", stsName)
+		return runHooks(ctx, audit, "verify", ns, stsName, []*proto.Hook{spec.Probe})
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	proto "github.com/aaronshifman/down-pvscope/api/down-pvscope/v1"
+	"github.com/aaronshifman/down-pvscope/pkg/util"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// resolveWindow prefers the window from the request and falls back to the one annotated on the namespace
+// the namespace's timezone applies to whichever window doesn't name its own - on a copy, the request is left alone
+func resolveWindow(requested *proto.MaintenanceWindow, annotations map[string]string) (*proto.MaintenanceWindow, error) {
+	window, _ := protobuf.Clone(requested).(*proto.MaintenanceWindow)
+	if window == nil {
+		var err error
+		window, err = util.ParseMaintenanceWindow(annotations[util.MaintenanceWindowAnnotation])
+		if err != nil {
+			return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("invalid %s annotation: %s", util.MaintenanceWindowAnnotation, err), "InvalidMaintenanceWindow", err)
+		}
+	}
+	if window == nil {
+		return nil, nil
+	}
+
+	if window.Timezone == "" {
+		window.Timezone = annotations[util.TimezoneAnnotation]
+	}
+
+	// catch a bad window now rather than when it's time to wait for it
+	_, _, err := util.NextWindow(window, time.Time{})
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("invalid maintenance window: %s", err), "InvalidMaintenanceWindow", err)
+	}
+	return window, nil
+}
+
+// waitForWindow durably sleeps until the maintenance window is open with at least `estimate` left in it
+func waitForWindow(ctx workflow.Context, audit *auditLog, window *proto.MaintenanceWindow, estimate time.Duration) error {
+	logger := workflow.GetLogger(ctx)
+	for {
+		now := workflow.Now(ctx)
+		start, end, err := util.NextWindow(window, now)
+		if err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "InvalidMaintenanceWindow", err)
+		}
+
+		if estimate > end.Sub(start) {
+			return temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("estimated downtime %s doesn't fit in a %s maintenance window", estimate, end.Sub(start)),
+				"MaintenanceWindowTooShort",
+				nil,
+			)
+		}
+
+		if now.Before(start) {
+			audit.record(ctx, "maintenance-window-wait", fmt.Sprintf("waiting for the window opening at %s", start))
+			logger.Info("Waiting for maintenance window", "opens", start, "closes", end)
+			err = workflow.Sleep(ctx, start.Sub(now))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if now.Add(estimate).After(end) {
+			audit.record(ctx, "maintenance-window-wait", fmt.Sprintf("estimated %s doesn't fit before the window closes at %s", estimate, end))
+			logger.Info("Too late in maintenance window - waiting for the next one", "closes", end, "estimate", estimate)
+			err = workflow.Sleep(ctx, end.Sub(now))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		logger.Info("Inside maintenance window", "closes", end)
+		return nil
+	}
+}